@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/ratelimit"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// adminServer is used by the operators to manage the running game (access is checked by the auth interceptors).
+type adminServer struct {
+	api.UnsafeDungeonsAndTrollsAdminServer
+	G       *dungeonsandtrolls.Game
+	Limiter *ratelimit.Limiter
+}
+
+func (s *adminServer) KickPlayer(ctx context.Context, r *api.KickPlayerRequest) (*emptypb.Empty, error) {
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	return &emptypb.Empty{}, s.G.KickPlayer(r.Id, r.Ban)
+}
+
+func (s *adminServer) RespawnPlayer(ctx context.Context, r *api.Identifier) (*emptypb.Empty, error) {
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	return &emptypb.Empty{}, s.G.RespawnPlayer(r.Id)
+}
+
+func (s *adminServer) TeleportPlayer(ctx context.Context, r *api.TeleportRequest) (*emptypb.Empty, error) {
+	if r.Coordinates == nil {
+		return &emptypb.Empty{}, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "coordinates not provided")
+	}
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	return &emptypb.Empty{}, s.G.TeleportPlayer(r.Id, r.Coordinates)
+}
+
+func (s *adminServer) RegenerateLevel(ctx context.Context, r *api.LevelRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.G.RegenerateLevel(r.Level)
+}
+
+func (s *adminServer) Grant(ctx context.Context, r *api.GrantRequest) (*emptypb.Empty, error) {
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	return &emptypb.Empty{}, s.G.Grant(r)
+}
+
+func (s *adminServer) SetGameProgress(ctx context.Context, r *api.GameProgress) (*emptypb.Empty, error) {
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	s.G.SetGameProgress(r)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *adminServer) GetEntity(ctx context.Context, r *api.Identifier) (*api.Entity, error) {
+	s.G.GameLock.RLock()
+	defer s.G.GameLock.RUnlock()
+	return s.G.GetEntity(r.Id)
+}
+
+func (s *adminServer) SpawnMonster(ctx context.Context, r *api.SpawnMonsterRequest) (*api.Identifier, error) {
+	if r.Coordinates == nil || r.Monster == nil {
+		return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "coordinates and monster have to be provided")
+	}
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	id, err := s.G.SpawnMonster(r.Coordinates, r.Monster)
+	return &api.Identifier{Id: id}, err
+}
+
+func (s *adminServer) DropItem(ctx context.Context, r *api.DropItemRequest) (*api.Identifier, error) {
+	if r.Coordinates == nil || r.Item == nil {
+		return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "coordinates and item have to be provided")
+	}
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	id, err := s.G.DropItem(r.Coordinates, r.Item)
+	return &api.Identifier{Id: id}, err
+}
+
+func (s *adminServer) SetTile(ctx context.Context, r *api.SetTileRequest) (*emptypb.Empty, error) {
+	if r.Coordinates == nil {
+		return &emptypb.Empty{}, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "coordinates not provided")
+	}
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	return &emptypb.Empty{}, s.G.SetTile(r.Coordinates, r.Type)
+}
+
+func (s *adminServer) PlaceEffect(ctx context.Context, r *api.PlaceEffectRequest) (*emptypb.Empty, error) {
+	if r.Coordinates == nil || r.Effect == nil {
+		return &emptypb.Empty{}, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "coordinates and effect have to be provided")
+	}
+	s.G.GameLock.Lock()
+	defer s.G.GameLock.Unlock()
+	return &emptypb.Empty{}, s.G.PlaceEffect(r.Coordinates, r.Effect)
+}
+
+func (s *adminServer) GetApiKeysUsage(ctx context.Context, _ *emptypb.Empty) (*api.ApiKeysUsage, error) {
+	usage := &api.ApiKeysUsage{}
+	for _, u := range s.Limiter.Usage() {
+		ku := &api.ApiKeyUsage{
+			KeyId:    u.KeyId,
+			Requests: u.Requests,
+			Rejected: u.Rejected,
+			Blocking: int32(u.Blocking),
+		}
+		if owner := s.G.ApiKeyOwner(u.KeyId); owner != "" {
+			ku.Owner = &owner
+		}
+		usage.Usage = append(usage.Usage, ku)
+	}
+	return usage, nil
+}
+
+func (s *adminServer) GetBalance(ctx context.Context, _ *emptypb.Empty) (*api.Balance, error) {
+	s.G.GameLock.RLock()
+	defer s.G.GameLock.RUnlock()
+	return s.G.GetBalance(), nil
+}
+
+func (s *adminServer) Step(ctx context.Context, r *api.StepRequest) (*api.StepResult, error) {
+	// the game loop takes the GameLock to process the ticks
+	tick, err := s.G.Step(ctx, r.Ticks)
+	if err != nil {
+		return nil, err
+	}
+	return &api.StepResult{Tick: tick}, nil
+}
@@ -0,0 +1,28 @@
+// Migrates the game stored in the JSON files (users.json, game.json and world.json) used before the storage buckets to
+// the storage configured by the same configuration as the server.
+//
+//	go run ./cmd/migrate <legacy dir> [-config file] [-storage.backend bolt] [-storage.path dir]
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/rs/zerolog/log"
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "" || os.Args[1][0] == '-' {
+		fmt.Fprintf(os.Stderr, "usage: %s <legacy dir> [configuration flags]\n", os.Args[0])
+		os.Exit(2)
+	}
+	cfg, err := config.Load(os.Args[2:])
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if err := dungeonsandtrolls.MigrateStorage(os.Args[1], cfg.Storage); err != nil {
+		log.Fatal().Err(err).Msg("Migration failed")
+	}
+}
@@ -0,0 +1,49 @@
+// Replays the game recorded by the server (see the recording section of the configuration) without the network and
+// checks that every tick produces the recorded events and state.
+//
+//	go run ./cmd/replay [-storage dir] replay.jsonl
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
+	"github.com/rs/zerolog/log"
+)
+
+func main() {
+	storage := flag.String("storage", "", "directory for the replayed game state (temporary by default)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-storage dir] <replay file>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	os.Exit(run(flag.Arg(0), *storage))
+}
+
+func run(path string, storage string) int {
+	if storage == "" {
+		dir, err := os.MkdirTemp("", "dnt-replay")
+		if err != nil {
+			log.Error().Err(err).Msg("")
+			return 1
+		}
+		defer os.RemoveAll(dir)
+		storage = dir
+	}
+
+	ticks, err := dungeonsandtrolls.Replay(path, storage)
+	if err != nil {
+		log.Error().Err(err).Msgf("Replay diverged after %d ticks", ticks)
+		return 1
+	}
+	log.Info().Msgf("%d ticks replayed", ticks)
+	return 0
+}
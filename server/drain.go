@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"strings"
+	"sync"
+)
+
+// drain rejects new requests once the server is shutting down, so nothing changes the game after its final state
+// is stored. The health checks are always served.
+type drain struct {
+	lock    sync.Mutex
+	closed  bool
+	running sync.WaitGroup
+}
+
+func isHealthCheck(method string) bool {
+	return strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// enter registers a new request, returns false if the server is shutting down.
+func (d *drain) enter() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.closed {
+		return false
+	}
+	d.running.Add(1)
+	return true
+}
+
+// close rejects the new requests and waits until the running unary requests finish (or the context is done).
+// The open streams are not waited for, they end when the game stops.
+func (d *drain) close(ctx context.Context) error {
+	d.lock.Lock()
+	d.closed = true
+	d.lock.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		d.running.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *drain) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isHealthCheck(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if !d.enter() {
+			return nil, errShuttingDown
+		}
+		defer d.running.Done()
+		return handler(ctx, req)
+	}
+}
+
+func (d *drain) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		d.lock.Lock()
+		closed := d.closed
+		d.lock.Unlock()
+		if closed && !isHealthCheck(info.FullMethod) {
+			return errShuttingDown
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,305 @@
+package dungeonsandtrolls
+
+import (
+	"fmt"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// Operator actions, the caller has to hold the GameLock (for writing). The actions are recorded to be replayed before
+// they change the game, so the replay assigns the same IDs (RegenerateLevel is recorded with the generated levels
+// once they replace the old level).
+
+func (g *Game) getPlayerById(id string) (*gameobject.Player, error) {
+	o, err := g.GetObjectById(id)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := o.(*gameobject.Player)
+	if !ok {
+		return nil, apierror.New(api.ErrorCode_INVALID_TARGET, "%s is not a player", id)
+	}
+	return p, nil
+}
+
+// KickPlayer removes the player from the game and revokes its API key. Banned players cannot register again.
+func (g *Game) KickPlayer(id string, ban bool) error {
+	g.recordAdmin("KickPlayer", &api.KickPlayerRequest{Id: id, Ban: ban})
+	p, err := g.getPlayerById(id)
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("kicking player %s (%s), ban: %t", p.GetId(), p.GetName(), ban)
+
+	if p.GetPosition() != nil {
+		g.removePlayerFromPosition(p)
+		g.markChanged(p.GetPosition())
+	}
+	g.Unregister(p)
+	delete(g.Players, p.GetName())
+	g.revokePlayerKeys(p.GetName())
+	var respawns []*gameobject.Player
+	for _, r := range g.Respawns {
+		if r != p {
+			respawns = append(respawns, r)
+		}
+	}
+	g.Respawns = respawns
+	g.CommandsLock.Lock()
+	delete(g.Commands, p.GetId())
+	g.CommandsLock.Unlock()
+
+	if ban {
+		g.BannedUsers[p.GetName()] = true
+	}
+	return nil
+}
+
+func (g *Game) IsBanned(username string) bool {
+	return g.BannedUsers[username]
+}
+
+func (g *Game) RespawnPlayer(id string) error {
+	g.recordAdmin("RespawnPlayer", &api.Identifier{Id: id})
+	p, err := g.getPlayerById(id)
+	if err != nil {
+		return err
+	}
+	g.Respawn(p, true)
+	return nil
+}
+
+func (g *Game) TeleportPlayer(id string, c *api.Coordinates) error {
+	g.recordAdmin("TeleportPlayer", &api.TeleportRequest{Id: id, Coordinates: c})
+	p, err := g.getPlayerById(id)
+	if err != nil {
+		return err
+	}
+	lc, err := g.GetCachedLevel(c.Level)
+	if err != nil {
+		return apierror.New(api.ErrorCode_NOT_FOUND, "level %d is not generated", c.Level)
+	}
+	if c.PositionX < 0 || c.PositionY < 0 || c.PositionX >= lc.Width || c.PositionY >= lc.Height {
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "position (%d, %d) is out of the level map", c.PositionX, c.PositionY)
+	}
+	o, err := g.GetObjectsOnPosition(c)
+	if err != nil {
+		return err
+	}
+	if o != nil && !o.IsFree {
+		return apierror.New(api.ErrorCode_INVALID_TARGET, "position (%d, %d) is not free", c.PositionX, c.PositionY)
+	}
+	g.MarkVisitedLevel(c.Level)
+	return g.ForceMoveCharacter(p, c)
+}
+
+// RegenerateLevel replaces the level with a newly generated one. Players on the ground floor stay in place, players
+// on other levels are respawned. The caller must not hold the GameLock, the level is generated without it and only
+// the replacement is locked.
+func (g *Game) RegenerateLevel(level int32) error {
+	g.GameLock.Lock()
+	if _, err := g.GetCachedLevel(level); err != nil {
+		g.GameLock.Unlock()
+		return apierror.New(api.ErrorCode_NOT_FOUND, "level %d is not generated", level)
+	}
+	seed := g.generatorSeed()
+	maxLevel := g.MaxLevelReached
+	g.GameLock.Unlock()
+	log.Info().Msgf("regenerating level %d", level)
+	output := g.runGenerator(level, level, maxLevel, seed)
+	m, err := ParseMap(output)
+	if err != nil {
+		return fmt.Errorf("parsing regenerated level %d failed: %w", level, err)
+	}
+
+	g.GameLock.Lock()
+	defer g.GameLock.Unlock()
+	lc, err := g.GetCachedLevel(level)
+	if err != nil {
+		return apierror.New(api.ErrorCode_NOT_FOUND, "level %d was removed while it was regenerated", level)
+	}
+	g.recordLevels(level, level, maxLevel, seed, output)
+	// recorded after the levels which the replay uses
+	g.recordAdmin("RegenerateLevel", &api.LevelRequest{Level: level})
+	respawnPlayers := g.unregisterLevel(level, lc)
+	g.removeLevel(level)
+	g.addIds(m)
+	g.AddParsedLevels(m)
+	if level == gameobject.ZeroLevel {
+		g.returnPlayersToZeroLevel()
+	}
+	for _, p := range respawnPlayers {
+		g.Respawn(p, false)
+	}
+	return nil
+}
+
+// Grant gives money, skill points and an item (which is equipped right away) to the player.
+func (g *Game) Grant(r *api.GrantRequest) error {
+	g.recordAdmin("Grant", r)
+	p, err := g.getPlayerById(r.Id)
+	if err != nil {
+		return err
+	}
+	if r.Money != nil {
+		p.Character.Money += *r.Money
+		g.profile(p.GetName()).MoneyReceived += *r.Money
+	}
+	if r.SkillPoints != nil {
+		p.Character.SkillPoints += *r.SkillPoints
+	}
+	if r.Item != nil {
+		nonNilItem(r.Item)
+		if r.Item.Id == "" {
+			r.Item.Id = g.NewId()
+		}
+		g.Register(r.Item)
+		err = Equip(g, p, r.Item)
+		if err != nil {
+			return err
+		}
+		return p.UpdateAttributes()
+	}
+	return nil
+}
+
+func (g *Game) SetGameProgress(gp *api.GameProgress) {
+	g.recordAdmin("SetGameProgress", gp)
+	if gp.Score != nil {
+		g.Score = *gp.Score
+		g.Game.Score = g.Score
+	}
+	if gp.MaxLevelReached != nil {
+		g.MaxLevelReached = *gp.MaxLevelReached
+	}
+}
+
+// GetEntity returns a copy of the full (unfiltered) state of the object.
+func (g *Game) GetEntity(id string) (*api.Entity, error) {
+	o, err := g.GetObjectById(id)
+	if err != nil {
+		return nil, err
+	}
+	e := &api.Entity{}
+	switch v := o.(type) {
+	case *gameobject.Player:
+		e.Entity = &api.Entity_Character{Character: v.Character}
+		e.Coordinates = v.GetPosition()
+	case *gameobject.Monster:
+		e.Entity = &api.Entity_Monster{Monster: v.Monster}
+		e.Coordinates = v.GetPosition()
+	case *api.Monster:
+		e.Entity = &api.Entity_Monster{Monster: v}
+	case *api.Item:
+		e.Entity = &api.Entity_Item{Item: v}
+	default:
+		return nil, apierror.New(api.ErrorCode_INVALID_TARGET, "object %s has unsupported type %T", id, o)
+	}
+	return proto.Clone(e).(*api.Entity), nil
+}
+
+// adminTile returns the objects on the coordinates (created if missing) to be modified.
+func (g *Game) adminTile(c *api.Coordinates) (*api.MapObjects, *LevelCache, error) {
+	lc, err := g.GetCachedLevel(c.Level)
+	if err != nil {
+		return nil, nil, apierror.New(api.ErrorCode_NOT_FOUND, "level %d is not generated", c.Level)
+	}
+	if c.PositionX < 0 || c.PositionY < 0 || c.PositionX >= lc.Width || c.PositionY >= lc.Height {
+		return nil, nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "position (%d, %d) is out of the level map", c.PositionX, c.PositionY)
+	}
+	o, err := g.AlwaysGetObjectsOnPosition(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	g.markChanged(c)
+	return o, lc, nil
+}
+
+// SpawnMonster places the monster on the coordinates the same way as the generated monsters are.
+func (g *Game) SpawnMonster(c *api.Coordinates, m *api.Monster) (string, error) {
+	g.recordAdmin("SpawnMonster", &api.SpawnMonsterRequest{Coordinates: c, Monster: m})
+	o, _, err := g.adminTile(c)
+	if err != nil {
+		return "", err
+	}
+	if !o.IsFree {
+		return "", apierror.New(api.ErrorCode_INVALID_TARGET, "position (%d, %d) is not free", c.PositionX, c.PositionY)
+	}
+	nonNilMonster(m)
+	m.Id = g.NewId()
+	for _, i := range m.EquippedItems {
+		for _, s := range i.Skills {
+			s.Id = g.NewId()
+		}
+	}
+	o.Monsters = append(o.Monsters, m)
+	g.Register(gameobject.CreateMonster(m, &api.Coordinates{
+		Level:     c.Level,
+		PositionX: c.PositionX,
+		PositionY: c.PositionY,
+	}))
+	return m.Id, nil
+}
+
+func (g *Game) DropItem(c *api.Coordinates, i *api.Item) (string, error) {
+	g.recordAdmin("DropItem", &api.DropItemRequest{Coordinates: c, Item: i})
+	o, _, err := g.adminTile(c)
+	if err != nil {
+		return "", err
+	}
+	if o.IsWall {
+		return "", apierror.New(api.ErrorCode_INVALID_TARGET, "position (%d, %d) is a wall", c.PositionX, c.PositionY)
+	}
+	nonNilItem(i)
+	i.Id = g.NewId()
+	for _, s := range i.Skills {
+		s.Id = g.NewId()
+	}
+	o.Items = append(o.Items, i)
+	g.Register(i)
+	return i.Id, nil
+}
+
+// SetTile changes the tile type and updates the walkability used for the path finding.
+func (g *Game) SetTile(c *api.Coordinates, t api.SetTileRequest_TileType) error {
+	g.recordAdmin("SetTile", &api.SetTileRequest{Coordinates: c, Type: t})
+	o, lc, err := g.adminTile(c)
+	if err != nil {
+		return err
+	}
+	if t != api.SetTileRequest_FREE && (len(o.Players) > 0 || len(o.Monsters) > 0) {
+		return apierror.New(api.ErrorCode_INVALID_TARGET, "position (%d, %d) is occupied", c.PositionX, c.PositionY)
+	}
+	o.IsWall = t == api.SetTileRequest_WALL
+	o.IsDoor = t == api.SetTileRequest_DOOR
+	o.IsStairs = t == api.SetTileRequest_STAIRS
+	o.IsFree = !o.IsWall && !o.IsDoor
+
+	gr := lc.Grid.Get(int(c.PositionX), int(c.PositionY))
+	gr.Walkable = o.IsFree
+	gr.Cost = 1
+	if o.Portal != nil || o.IsStairs {
+		gr.Cost = 5
+	}
+	return nil
+}
+
+func (g *Game) PlaceEffect(c *api.Coordinates, e *api.Effect) error {
+	g.recordAdmin("PlaceEffect", &api.PlaceEffectRequest{Coordinates: c, Effect: e})
+	if e.Duration <= 0 {
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "effect duration has to be positive")
+	}
+	o, _, err := g.adminTile(c)
+	if err != nil {
+		return err
+	}
+	if e.Effects == nil {
+		e.Effects = &api.Attributes{}
+	}
+	o.Effects = append(o.Effects, e)
+	return nil
+}
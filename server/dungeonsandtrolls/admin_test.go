@@ -0,0 +1,94 @@
+package dungeonsandtrolls
+
+import (
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+)
+
+func TestEditLevel(t *testing.T) {
+	g := NewGame(config.Default())
+	spawn := true
+	g.Game.Map.Levels = []*api.Level{{
+		Level:  1,
+		Width:  3,
+		Height: 3,
+		Objects: []*api.MapObjects{
+			{Position: &api.Position{PositionX: 0, PositionY: 0}, IsFree: true, IsSpawn: &spawn},
+		},
+	}}
+	if err := LevelsPostProcessing(g, g.Game.Map, &g.mapCache); err != nil {
+		t.Fatal(err)
+	}
+	lc, _ := g.GetCachedLevel(1)
+
+	c := &api.Coordinates{Level: 1, PositionX: 1, PositionY: 2}
+	if err := g.SetTile(c, api.SetTileRequest_WALL); err != nil {
+		t.Fatal(err)
+	}
+	if lc.Grid.Get(1, 2).Walkable {
+		t.Fatal("wall should not be walkable")
+	}
+	if len(g.Game.Map.Levels[0].Objects) != 2 {
+		t.Fatal("new tile should be added to the game map")
+	}
+	if _, err := g.SpawnMonster(c, &api.Monster{Name: "goblin"}); apierror.Code(err) != api.ErrorCode_INVALID_TARGET {
+		t.Fatal("monster should not be spawned in a wall")
+	}
+	if err := g.SetTile(c, api.SetTileRequest_FREE); err != nil {
+		t.Fatal(err)
+	}
+	if !lc.Grid.Get(1, 2).Walkable {
+		t.Fatal("free tile should be walkable")
+	}
+
+	id, err := g.SpawnMonster(c, &api.Monster{Name: "goblin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, err := g.GetObjectById(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := o.(*gameobject.Monster); !ok {
+		t.Fatalf("expected registered monster, got %T", o)
+	}
+	if mo, _ := g.GetObjectsOnPosition(c); len(mo.Monsters) != 1 {
+		t.Fatal("monster should be on the tile")
+	}
+	if err := g.SetTile(c, api.SetTileRequest_DOOR); apierror.Code(err) != api.ErrorCode_INVALID_TARGET {
+		t.Fatal("occupied tile should not become a door")
+	}
+	if _, err := g.DropItem(&api.Coordinates{Level: 1, PositionX: 3, PositionY: 0}, &api.Item{}); apierror.Code(err) != api.ErrorCode_INVALID_ARGUMENT {
+		t.Fatal("item should not be dropped out of the map")
+	}
+}
+
+func TestRegenerateLevel(t *testing.T) {
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	g := NewGame(c)
+	generated := 0
+	g.generate = func(start int32, end int32, max int32, seed int64) string {
+		if !g.GameLock.TryLock() {
+			t.Error("level should be generated without the GameLock")
+		} else {
+			g.GameLock.Unlock()
+		}
+		generated++
+		return testGeneratorOutput(5)
+	}
+	g.AddLevel(gameobject.ZeroLevel)
+	if err := g.RegenerateLevel(gameobject.ZeroLevel); err != nil {
+		t.Fatal(err)
+	}
+	if generated != 2 || len(g.Game.Map.Levels) != 1 {
+		t.Fatalf("level should be replaced, generated %d times, %d levels", generated, len(g.Game.Map.Levels))
+	}
+	if err := g.RegenerateLevel(3); apierror.Code(err) != api.ErrorCode_NOT_FOUND {
+		t.Fatal("missing level should not be generated")
+	}
+}
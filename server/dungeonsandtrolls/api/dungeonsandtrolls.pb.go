@@ -22,6 +22,109 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ErrorCode int32
+
+const (
+	ErrorCode_NO_ERROR                  ErrorCode = 0
+	ErrorCode_UNKNOWN_ERROR             ErrorCode = 1
+	ErrorCode_STUNNED                   ErrorCode = 2
+	ErrorCode_OUT_OF_RANGE              ErrorCode = 3
+	ErrorCode_NO_LINE_OF_SIGHT          ErrorCode = 4
+	ErrorCode_INSUFFICIENT_FUNDS        ErrorCode = 5
+	ErrorCode_INSUFFICIENT_SKILL_POINTS ErrorCode = 6
+	ErrorCode_REQUIREMENTS_NOT_MET      ErrorCode = 7
+	ErrorCode_NOT_FOUND                 ErrorCode = 8
+	ErrorCode_INVALID_TARGET            ErrorCode = 9
+	ErrorCode_INVALID_ARGUMENT          ErrorCode = 10
+	ErrorCode_NO_PATH                   ErrorCode = 11
+	ErrorCode_CONFLICTING_COMMANDS      ErrorCode = 12
+	ErrorCode_IN_COMBAT                 ErrorCode = 13
+	ErrorCode_NOT_AVAILABLE             ErrorCode = 14
+	ErrorCode_NOT_ADMIN                 ErrorCode = 15
+	ErrorCode_ADMIN_NOT_ALLOWED         ErrorCode = 16
+	ErrorCode_INVALID_API_KEY           ErrorCode = 17
+	ErrorCode_ALREADY_EXISTS            ErrorCode = 18
+	ErrorCode_PERMISSION_DENIED         ErrorCode = 19
+	ErrorCode_RATE_LIMITED              ErrorCode = 20
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "NO_ERROR",
+		1:  "UNKNOWN_ERROR",
+		2:  "STUNNED",
+		3:  "OUT_OF_RANGE",
+		4:  "NO_LINE_OF_SIGHT",
+		5:  "INSUFFICIENT_FUNDS",
+		6:  "INSUFFICIENT_SKILL_POINTS",
+		7:  "REQUIREMENTS_NOT_MET",
+		8:  "NOT_FOUND",
+		9:  "INVALID_TARGET",
+		10: "INVALID_ARGUMENT",
+		11: "NO_PATH",
+		12: "CONFLICTING_COMMANDS",
+		13: "IN_COMBAT",
+		14: "NOT_AVAILABLE",
+		15: "NOT_ADMIN",
+		16: "ADMIN_NOT_ALLOWED",
+		17: "INVALID_API_KEY",
+		18: "ALREADY_EXISTS",
+		19: "PERMISSION_DENIED",
+		20: "RATE_LIMITED",
+	}
+	ErrorCode_value = map[string]int32{
+		"NO_ERROR":                  0,
+		"UNKNOWN_ERROR":             1,
+		"STUNNED":                   2,
+		"OUT_OF_RANGE":              3,
+		"NO_LINE_OF_SIGHT":          4,
+		"INSUFFICIENT_FUNDS":        5,
+		"INSUFFICIENT_SKILL_POINTS": 6,
+		"REQUIREMENTS_NOT_MET":      7,
+		"NOT_FOUND":                 8,
+		"INVALID_TARGET":            9,
+		"INVALID_ARGUMENT":          10,
+		"NO_PATH":                   11,
+		"CONFLICTING_COMMANDS":      12,
+		"IN_COMBAT":                 13,
+		"NOT_AVAILABLE":             14,
+		"NOT_ADMIN":                 15,
+		"ADMIN_NOT_ALLOWED":         16,
+		"INVALID_API_KEY":           17,
+		"ALREADY_EXISTS":            18,
+		"PERMISSION_DENIED":         19,
+		"RATE_LIMITED":              20,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dungeonsandtrolls_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{0}
+}
+
 type DamageType int32
 
 const (
@@ -64,11 +167,11 @@ func (x DamageType) String() string {
 }
 
 func (DamageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dungeonsandtrolls_proto_enumTypes[0].Descriptor()
+	return file_proto_dungeonsandtrolls_proto_enumTypes[1].Descriptor()
 }
 
 func (DamageType) Type() protoreflect.EnumType {
-	return &file_proto_dungeonsandtrolls_proto_enumTypes[0]
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[1]
 }
 
 func (x DamageType) Number() protoreflect.EnumNumber {
@@ -77,7 +180,114 @@ func (x DamageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DamageType.Descriptor instead.
 func (DamageType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{0}
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{1}
+}
+
+type CommandsBatchStatus_Status int32
+
+const (
+	CommandsBatchStatus_ACCEPTED CommandsBatchStatus_Status = 0
+	CommandsBatchStatus_REJECTED CommandsBatchStatus_Status = 1
+	CommandsBatchStatus_EXECUTED CommandsBatchStatus_Status = 2
+	CommandsBatchStatus_REPLACED CommandsBatchStatus_Status = 3
+	CommandsBatchStatus_DROPPED  CommandsBatchStatus_Status = 4
+)
+
+// Enum value maps for CommandsBatchStatus_Status.
+var (
+	CommandsBatchStatus_Status_name = map[int32]string{
+		0: "ACCEPTED",
+		1: "REJECTED",
+		2: "EXECUTED",
+		3: "REPLACED",
+		4: "DROPPED",
+	}
+	CommandsBatchStatus_Status_value = map[string]int32{
+		"ACCEPTED": 0,
+		"REJECTED": 1,
+		"EXECUTED": 2,
+		"REPLACED": 3,
+		"DROPPED":  4,
+	}
+)
+
+func (x CommandsBatchStatus_Status) Enum() *CommandsBatchStatus_Status {
+	p := new(CommandsBatchStatus_Status)
+	*p = x
+	return p
+}
+
+func (x CommandsBatchStatus_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandsBatchStatus_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dungeonsandtrolls_proto_enumTypes[2].Descriptor()
+}
+
+func (CommandsBatchStatus_Status) Type() protoreflect.EnumType {
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[2]
+}
+
+func (x CommandsBatchStatus_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandsBatchStatus_Status.Descriptor instead.
+func (CommandsBatchStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type CommandStatus_Status int32
+
+const (
+	CommandStatus_ACCEPTED CommandStatus_Status = 0
+	CommandStatus_REJECTED CommandStatus_Status = 1
+	CommandStatus_EXECUTED CommandStatus_Status = 2
+	CommandStatus_FAILED   CommandStatus_Status = 3
+)
+
+// Enum value maps for CommandStatus_Status.
+var (
+	CommandStatus_Status_name = map[int32]string{
+		0: "ACCEPTED",
+		1: "REJECTED",
+		2: "EXECUTED",
+		3: "FAILED",
+	}
+	CommandStatus_Status_value = map[string]int32{
+		"ACCEPTED": 0,
+		"REJECTED": 1,
+		"EXECUTED": 2,
+		"FAILED":   3,
+	}
+)
+
+func (x CommandStatus_Status) Enum() *CommandStatus_Status {
+	p := new(CommandStatus_Status)
+	*p = x
+	return p
+}
+
+func (x CommandStatus_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandStatus_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dungeonsandtrolls_proto_enumTypes[3].Descriptor()
+}
+
+func (CommandStatus_Status) Type() protoreflect.EnumType {
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[3]
+}
+
+func (x CommandStatus_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandStatus_Status.Descriptor instead.
+func (CommandStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type Skill_Target int32
@@ -113,11 +323,11 @@ func (x Skill_Target) String() string {
 }
 
 func (Skill_Target) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dungeonsandtrolls_proto_enumTypes[1].Descriptor()
+	return file_proto_dungeonsandtrolls_proto_enumTypes[4].Descriptor()
 }
 
 func (Skill_Target) Type() protoreflect.EnumType {
-	return &file_proto_dungeonsandtrolls_proto_enumTypes[1]
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[4]
 }
 
 func (x Skill_Target) Number() protoreflect.EnumNumber {
@@ -126,7 +336,7 @@ func (x Skill_Target) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Skill_Target.Descriptor instead.
 func (Skill_Target) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{28, 0}
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type Item_Type int32
@@ -171,11 +381,11 @@ func (x Item_Type) String() string {
 }
 
 func (Item_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dungeonsandtrolls_proto_enumTypes[2].Descriptor()
+	return file_proto_dungeonsandtrolls_proto_enumTypes[5].Descriptor()
 }
 
 func (Item_Type) Type() protoreflect.EnumType {
-	return &file_proto_dungeonsandtrolls_proto_enumTypes[2]
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[5]
 }
 
 func (x Item_Type) Number() protoreflect.EnumNumber {
@@ -184,49 +394,52 @@ func (x Item_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Item_Type.Descriptor instead.
 func (Item_Type) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{29, 0}
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{33, 0}
 }
 
 type Event_Type int32
 
 const (
-	Event_DAMAGE  Event_Type = 0
-	Event_MESSAGE Event_Type = 1
-	Event_BUY     Event_Type = 2
-	Event_EQUIP   Event_Type = 3
-	Event_ERROR   Event_Type = 4
-	Event_SKILL   Event_Type = 5
-	Event_DEATH   Event_Type = 6
-	Event_SCORE   Event_Type = 7
-	Event_MOVE    Event_Type = 8
-	Event_AOE     Event_Type = 9
+	Event_DAMAGE      Event_Type = 0
+	Event_MESSAGE     Event_Type = 1
+	Event_BUY         Event_Type = 2
+	Event_EQUIP       Event_Type = 3
+	Event_ERROR       Event_Type = 4
+	Event_SKILL       Event_Type = 5
+	Event_DEATH       Event_Type = 6
+	Event_SCORE       Event_Type = 7
+	Event_MOVE        Event_Type = 8
+	Event_AOE         Event_Type = 9
+	Event_MISSED_TICK Event_Type = 10
 )
 
 // Enum value maps for Event_Type.
 var (
 	Event_Type_name = map[int32]string{
-		0: "DAMAGE",
-		1: "MESSAGE",
-		2: "BUY",
-		3: "EQUIP",
-		4: "ERROR",
-		5: "SKILL",
-		6: "DEATH",
-		7: "SCORE",
-		8: "MOVE",
-		9: "AOE",
+		0:  "DAMAGE",
+		1:  "MESSAGE",
+		2:  "BUY",
+		3:  "EQUIP",
+		4:  "ERROR",
+		5:  "SKILL",
+		6:  "DEATH",
+		7:  "SCORE",
+		8:  "MOVE",
+		9:  "AOE",
+		10: "MISSED_TICK",
 	}
 	Event_Type_value = map[string]int32{
-		"DAMAGE":  0,
-		"MESSAGE": 1,
-		"BUY":     2,
-		"EQUIP":   3,
-		"ERROR":   4,
-		"SKILL":   5,
-		"DEATH":   6,
-		"SCORE":   7,
-		"MOVE":    8,
-		"AOE":     9,
+		"DAMAGE":      0,
+		"MESSAGE":     1,
+		"BUY":         2,
+		"EQUIP":       3,
+		"ERROR":       4,
+		"SKILL":       5,
+		"DEATH":       6,
+		"SCORE":       7,
+		"MOVE":        8,
+		"AOE":         9,
+		"MISSED_TICK": 10,
 	}
 )
 
@@ -241,11 +454,11 @@ func (x Event_Type) String() string {
 }
 
 func (Event_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_dungeonsandtrolls_proto_enumTypes[3].Descriptor()
+	return file_proto_dungeonsandtrolls_proto_enumTypes[6].Descriptor()
 }
 
 func (Event_Type) Type() protoreflect.EnumType {
-	return &file_proto_dungeonsandtrolls_proto_enumTypes[3]
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[6]
 }
 
 func (x Event_Type) Number() protoreflect.EnumNumber {
@@ -254,7 +467,163 @@ func (x Event_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Event_Type.Descriptor instead.
 func (Event_Type) EnumDescriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{41, 0}
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{45, 0}
+}
+
+type SetTileRequest_TileType int32
+
+const (
+	SetTileRequest_FREE   SetTileRequest_TileType = 0
+	SetTileRequest_WALL   SetTileRequest_TileType = 1
+	SetTileRequest_DOOR   SetTileRequest_TileType = 2
+	SetTileRequest_STAIRS SetTileRequest_TileType = 3
+)
+
+// Enum value maps for SetTileRequest_TileType.
+var (
+	SetTileRequest_TileType_name = map[int32]string{
+		0: "FREE",
+		1: "WALL",
+		2: "DOOR",
+		3: "STAIRS",
+	}
+	SetTileRequest_TileType_value = map[string]int32{
+		"FREE":   0,
+		"WALL":   1,
+		"DOOR":   2,
+		"STAIRS": 3,
+	}
+)
+
+func (x SetTileRequest_TileType) Enum() *SetTileRequest_TileType {
+	p := new(SetTileRequest_TileType)
+	*p = x
+	return p
+}
+
+func (x SetTileRequest_TileType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetTileRequest_TileType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dungeonsandtrolls_proto_enumTypes[7].Descriptor()
+}
+
+func (SetTileRequest_TileType) Type() protoreflect.EnumType {
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[7]
+}
+
+func (x SetTileRequest_TileType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetTileRequest_TileType.Descriptor instead.
+func (SetTileRequest_TileType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{68, 0}
+}
+
+type LeaderboardRequest_Measure int32
+
+const (
+	LeaderboardRequest_DEEPEST_LEVEL LeaderboardRequest_Measure = 0
+	LeaderboardRequest_KILLS         LeaderboardRequest_Measure = 1
+	LeaderboardRequest_SCORE         LeaderboardRequest_Measure = 2
+	LeaderboardRequest_SURVIVAL      LeaderboardRequest_Measure = 3
+	LeaderboardRequest_DAMAGE_DEALT  LeaderboardRequest_Measure = 4
+	LeaderboardRequest_ASSISTS       LeaderboardRequest_Measure = 5
+)
+
+// Enum value maps for LeaderboardRequest_Measure.
+var (
+	LeaderboardRequest_Measure_name = map[int32]string{
+		0: "DEEPEST_LEVEL",
+		1: "KILLS",
+		2: "SCORE",
+		3: "SURVIVAL",
+		4: "DAMAGE_DEALT",
+		5: "ASSISTS",
+	}
+	LeaderboardRequest_Measure_value = map[string]int32{
+		"DEEPEST_LEVEL": 0,
+		"KILLS":         1,
+		"SCORE":         2,
+		"SURVIVAL":      3,
+		"DAMAGE_DEALT":  4,
+		"ASSISTS":       5,
+	}
+)
+
+func (x LeaderboardRequest_Measure) Enum() *LeaderboardRequest_Measure {
+	p := new(LeaderboardRequest_Measure)
+	*p = x
+	return p
+}
+
+func (x LeaderboardRequest_Measure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardRequest_Measure) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dungeonsandtrolls_proto_enumTypes[8].Descriptor()
+}
+
+func (LeaderboardRequest_Measure) Type() protoreflect.EnumType {
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[8]
+}
+
+func (x LeaderboardRequest_Measure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardRequest_Measure.Descriptor instead.
+func (LeaderboardRequest_Measure) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{82, 0}
+}
+
+type LeaderboardRequest_Scope int32
+
+const (
+	LeaderboardRequest_RUN      LeaderboardRequest_Scope = 0
+	LeaderboardRequest_ALL_TIME LeaderboardRequest_Scope = 1
+)
+
+// Enum value maps for LeaderboardRequest_Scope.
+var (
+	LeaderboardRequest_Scope_name = map[int32]string{
+		0: "RUN",
+		1: "ALL_TIME",
+	}
+	LeaderboardRequest_Scope_value = map[string]int32{
+		"RUN":      0,
+		"ALL_TIME": 1,
+	}
+)
+
+func (x LeaderboardRequest_Scope) Enum() *LeaderboardRequest_Scope {
+	p := new(LeaderboardRequest_Scope)
+	*p = x
+	return p
+}
+
+func (x LeaderboardRequest_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardRequest_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dungeonsandtrolls_proto_enumTypes[9].Descriptor()
+}
+
+func (LeaderboardRequest_Scope) Type() protoreflect.EnumType {
+	return &file_proto_dungeonsandtrolls_proto_enumTypes[9]
+}
+
+func (x LeaderboardRequest_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardRequest_Scope.Descriptor instead.
+func (LeaderboardRequest_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{82, 1}
 }
 
 type IdentifierWithParams struct {
@@ -1063,9 +1432,10 @@ type GameStateParams struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blocking *bool `protobuf:"varint,1,opt,name=blocking,proto3,oneof" json:"blocking,omitempty"`
-	Items    *bool `protobuf:"varint,2,opt,name=items,proto3,oneof" json:"items,omitempty"`
-	FogOfWar *bool `protobuf:"varint,3,opt,name=fog_of_war,json=fogOfWar,proto3,oneof" json:"fog_of_war,omitempty"`
+	Blocking  *bool  `protobuf:"varint,1,opt,name=blocking,proto3,oneof" json:"blocking,omitempty"`
+	Items     *bool  `protobuf:"varint,2,opt,name=items,proto3,oneof" json:"items,omitempty"`
+	FogOfWar  *bool  `protobuf:"varint,3,opt,name=fog_of_war,json=fogOfWar,proto3,oneof" json:"fog_of_war,omitempty"`
+	SinceTick *int32 `protobuf:"varint,4,opt,name=since_tick,json=sinceTick,proto3,oneof" json:"since_tick,omitempty"`
 }
 
 func (x *GameStateParams) Reset() {
@@ -1121,6 +1491,13 @@ func (x *GameStateParams) GetFogOfWar() bool {
 	return false
 }
 
+func (x *GameStateParams) GetSinceTick() int32 {
+	if x != nil && x.SinceTick != nil {
+		return *x.SinceTick
+	}
+	return 0
+}
+
 type AvailableLevels struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1173,10 +1550,11 @@ type GameStateParamsLevel struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blocking *bool `protobuf:"varint,1,opt,name=blocking,proto3,oneof" json:"blocking,omitempty"`
-	Items    *bool `protobuf:"varint,2,opt,name=items,proto3,oneof" json:"items,omitempty"`
-	FogOfWar *bool `protobuf:"varint,3,opt,name=fog_of_war,json=fogOfWar,proto3,oneof" json:"fog_of_war,omitempty"`
-	Level    int32 `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Blocking  *bool  `protobuf:"varint,1,opt,name=blocking,proto3,oneof" json:"blocking,omitempty"`
+	Items     *bool  `protobuf:"varint,2,opt,name=items,proto3,oneof" json:"items,omitempty"`
+	FogOfWar  *bool  `protobuf:"varint,3,opt,name=fog_of_war,json=fogOfWar,proto3,oneof" json:"fog_of_war,omitempty"`
+	Level     int32  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	SinceTick *int32 `protobuf:"varint,5,opt,name=since_tick,json=sinceTick,proto3,oneof" json:"since_tick,omitempty"`
 }
 
 func (x *GameStateParamsLevel) Reset() {
@@ -1239,6 +1617,13 @@ func (x *GameStateParamsLevel) GetLevel() int32 {
 	return 0
 }
 
+func (x *GameStateParamsLevel) GetSinceTick() int32 {
+	if x != nil && x.SinceTick != nil {
+		return *x.SinceTick
+	}
+	return 0
+}
+
 type CommandsBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1326,16 +1711,20 @@ func (x *CommandsBatch) GetAssignSkillPoints() *Attributes {
 	return nil
 }
 
-type CommandsForMonsters struct {
+type CommandsBatchStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commands map[string]*CommandsBatch `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Batch  int32                      `protobuf:"varint,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Status CommandsBatchStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=dungeonsandtrolls.CommandsBatchStatus_Status" json:"status,omitempty"`
+	Tick   int32                      `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	Reason *string                    `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Result *CommandResult             `protobuf:"bytes,5,opt,name=result,proto3,oneof" json:"result,omitempty"`
 }
 
-func (x *CommandsForMonsters) Reset() {
-	*x = CommandsForMonsters{}
+func (x *CommandsBatchStatus) Reset() {
+	*x = CommandsBatchStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,13 +1732,13 @@ func (x *CommandsForMonsters) Reset() {
 	}
 }
 
-func (x *CommandsForMonsters) String() string {
+func (x *CommandsBatchStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommandsForMonsters) ProtoMessage() {}
+func (*CommandsBatchStatus) ProtoMessage() {}
 
-func (x *CommandsForMonsters) ProtoReflect() protoreflect.Message {
+func (x *CommandsBatchStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1361,32 +1750,58 @@ func (x *CommandsForMonsters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommandsForMonsters.ProtoReflect.Descriptor instead.
-func (*CommandsForMonsters) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommandsBatchStatus.ProtoReflect.Descriptor instead.
+func (*CommandsBatchStatus) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CommandsForMonsters) GetCommands() map[string]*CommandsBatch {
+func (x *CommandsBatchStatus) GetBatch() int32 {
 	if x != nil {
-		return x.Commands
+		return x.Batch
+	}
+	return 0
+}
+
+func (x *CommandsBatchStatus) GetStatus() CommandsBatchStatus_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CommandsBatchStatus_ACCEPTED
+}
+
+func (x *CommandsBatchStatus) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *CommandsBatchStatus) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *CommandsBatchStatus) GetResult() *CommandResult {
+	if x != nil {
+		return x.Result
 	}
 	return nil
 }
 
-type Effect struct {
+type CommandStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DamageAmount float32     `protobuf:"fixed32,1,opt,name=damage_amount,json=damageAmount,proto3" json:"damage_amount,omitempty"`
-	DamageType   DamageType  `protobuf:"varint,2,opt,name=damage_type,json=damageType,proto3,enum=dungeonsandtrolls.DamageType" json:"damage_type,omitempty"`
-	Effects      *Attributes `protobuf:"bytes,3,opt,name=effects,proto3" json:"effects,omitempty"`
-	Duration     int32       `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	XCasterId    *string     `protobuf:"bytes,5,opt,name=_caster_id,json=CasterId,proto3,oneof" json:"_caster_id,omitempty"`
+	Status    CommandStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=dungeonsandtrolls.CommandStatus_Status" json:"status,omitempty"`
+	ErrorCode ErrorCode            `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3,enum=dungeonsandtrolls.ErrorCode" json:"error_code,omitempty"`
+	Message   *string              `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
 }
 
-func (x *Effect) Reset() {
-	*x = Effect{}
+func (x *CommandStatus) Reset() {
+	*x = CommandStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1394,13 +1809,13 @@ func (x *Effect) Reset() {
 	}
 }
 
-func (x *Effect) String() string {
+func (x *CommandStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Effect) ProtoMessage() {}
+func (*CommandStatus) ProtoMessage() {}
 
-func (x *Effect) ProtoReflect() protoreflect.Message {
+func (x *CommandStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1412,69 +1827,48 @@ func (x *Effect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Effect.ProtoReflect.Descriptor instead.
-func (*Effect) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommandStatus.ProtoReflect.Descriptor instead.
+func (*CommandStatus) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Effect) GetDamageAmount() float32 {
-	if x != nil {
-		return x.DamageAmount
-	}
-	return 0
-}
-
-func (x *Effect) GetDamageType() DamageType {
-	if x != nil {
-		return x.DamageType
-	}
-	return DamageType_none
-}
-
-func (x *Effect) GetEffects() *Attributes {
+func (x *CommandStatus) GetStatus() CommandStatus_Status {
 	if x != nil {
-		return x.Effects
+		return x.Status
 	}
-	return nil
+	return CommandStatus_ACCEPTED
 }
 
-func (x *Effect) GetDuration() int32 {
+func (x *CommandStatus) GetErrorCode() ErrorCode {
 	if x != nil {
-		return x.Duration
+		return x.ErrorCode
 	}
-	return 0
+	return ErrorCode_NO_ERROR
 }
 
-func (x *Effect) GetXCasterId() string {
-	if x != nil && x.XCasterId != nil {
-		return *x.XCasterId
+func (x *CommandStatus) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
 	return ""
 }
 
-type Attributes struct {
+type CommandResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Strength       *float32 `protobuf:"fixed32,1,opt,name=strength,proto3,oneof" json:"strength,omitempty"`
-	Dexterity      *float32 `protobuf:"fixed32,2,opt,name=dexterity,proto3,oneof" json:"dexterity,omitempty"`
-	Intelligence   *float32 `protobuf:"fixed32,3,opt,name=intelligence,proto3,oneof" json:"intelligence,omitempty"`
-	Willpower      *float32 `protobuf:"fixed32,4,opt,name=willpower,proto3,oneof" json:"willpower,omitempty"`
-	Constitution   *float32 `protobuf:"fixed32,5,opt,name=constitution,proto3,oneof" json:"constitution,omitempty"`
-	SlashResist    *float32 `protobuf:"fixed32,6,opt,name=slash_resist,json=slashResist,proto3,oneof" json:"slash_resist,omitempty"`
-	PierceResist   *float32 `protobuf:"fixed32,7,opt,name=pierce_resist,json=pierceResist,proto3,oneof" json:"pierce_resist,omitempty"`
-	FireResist     *float32 `protobuf:"fixed32,8,opt,name=fire_resist,json=fireResist,proto3,oneof" json:"fire_resist,omitempty"`
-	PoisonResist   *float32 `protobuf:"fixed32,9,opt,name=poison_resist,json=poisonResist,proto3,oneof" json:"poison_resist,omitempty"`
-	ElectricResist *float32 `protobuf:"fixed32,10,opt,name=electric_resist,json=electricResist,proto3,oneof" json:"electric_resist,omitempty"`
-	Life           *float32 `protobuf:"fixed32,11,opt,name=life,proto3,oneof" json:"life,omitempty"`
-	Stamina        *float32 `protobuf:"fixed32,12,opt,name=stamina,proto3,oneof" json:"stamina,omitempty"`
-	Mana           *float32 `protobuf:"fixed32,13,opt,name=mana,proto3,oneof" json:"mana,omitempty"`
-	Constant       *float32 `protobuf:"fixed32,14,opt,name=constant,proto3,oneof" json:"constant,omitempty"`
+	Tick              int32          `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Yell              *CommandStatus `protobuf:"bytes,2,opt,name=yell,proto3,oneof" json:"yell,omitempty"`
+	Skill             *CommandStatus `protobuf:"bytes,3,opt,name=skill,proto3,oneof" json:"skill,omitempty"`
+	Move              *CommandStatus `protobuf:"bytes,4,opt,name=move,proto3,oneof" json:"move,omitempty"`
+	Buy               *CommandStatus `protobuf:"bytes,5,opt,name=buy,proto3,oneof" json:"buy,omitempty"`
+	PickUp            *CommandStatus `protobuf:"bytes,6,opt,name=pick_up,json=pickUp,proto3,oneof" json:"pick_up,omitempty"`
+	AssignSkillPoints *CommandStatus `protobuf:"bytes,7,opt,name=assign_skill_points,json=assignSkillPoints,proto3,oneof" json:"assign_skill_points,omitempty"`
 }
 
-func (x *Attributes) Reset() {
-	*x = Attributes{}
+func (x *CommandResult) Reset() {
+	*x = CommandResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1482,13 +1876,13 @@ func (x *Attributes) Reset() {
 	}
 }
 
-func (x *Attributes) String() string {
+func (x *CommandResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attributes) ProtoMessage() {}
+func (*CommandResult) ProtoMessage() {}
 
-func (x *Attributes) ProtoReflect() protoreflect.Message {
+func (x *CommandResult) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1500,132 +1894,71 @@ func (x *Attributes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attributes.ProtoReflect.Descriptor instead.
-func (*Attributes) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommandResult.ProtoReflect.Descriptor instead.
+func (*CommandResult) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Attributes) GetStrength() float32 {
-	if x != nil && x.Strength != nil {
-		return *x.Strength
-	}
-	return 0
-}
-
-func (x *Attributes) GetDexterity() float32 {
-	if x != nil && x.Dexterity != nil {
-		return *x.Dexterity
-	}
-	return 0
-}
-
-func (x *Attributes) GetIntelligence() float32 {
-	if x != nil && x.Intelligence != nil {
-		return *x.Intelligence
-	}
-	return 0
-}
-
-func (x *Attributes) GetWillpower() float32 {
-	if x != nil && x.Willpower != nil {
-		return *x.Willpower
-	}
-	return 0
-}
-
-func (x *Attributes) GetConstitution() float32 {
-	if x != nil && x.Constitution != nil {
-		return *x.Constitution
-	}
-	return 0
-}
-
-func (x *Attributes) GetSlashResist() float32 {
-	if x != nil && x.SlashResist != nil {
-		return *x.SlashResist
-	}
-	return 0
-}
-
-func (x *Attributes) GetPierceResist() float32 {
-	if x != nil && x.PierceResist != nil {
-		return *x.PierceResist
-	}
-	return 0
-}
-
-func (x *Attributes) GetFireResist() float32 {
-	if x != nil && x.FireResist != nil {
-		return *x.FireResist
+func (x *CommandResult) GetTick() int32 {
+	if x != nil {
+		return x.Tick
 	}
 	return 0
 }
 
-func (x *Attributes) GetPoisonResist() float32 {
-	if x != nil && x.PoisonResist != nil {
-		return *x.PoisonResist
+func (x *CommandResult) GetYell() *CommandStatus {
+	if x != nil {
+		return x.Yell
 	}
-	return 0
+	return nil
 }
 
-func (x *Attributes) GetElectricResist() float32 {
-	if x != nil && x.ElectricResist != nil {
-		return *x.ElectricResist
+func (x *CommandResult) GetSkill() *CommandStatus {
+	if x != nil {
+		return x.Skill
 	}
-	return 0
+	return nil
 }
 
-func (x *Attributes) GetLife() float32 {
-	if x != nil && x.Life != nil {
-		return *x.Life
+func (x *CommandResult) GetMove() *CommandStatus {
+	if x != nil {
+		return x.Move
 	}
-	return 0
+	return nil
 }
 
-func (x *Attributes) GetStamina() float32 {
-	if x != nil && x.Stamina != nil {
-		return *x.Stamina
+func (x *CommandResult) GetBuy() *CommandStatus {
+	if x != nil {
+		return x.Buy
 	}
-	return 0
+	return nil
 }
 
-func (x *Attributes) GetMana() float32 {
-	if x != nil && x.Mana != nil {
-		return *x.Mana
+func (x *CommandResult) GetPickUp() *CommandStatus {
+	if x != nil {
+		return x.PickUp
 	}
-	return 0
+	return nil
 }
 
-func (x *Attributes) GetConstant() float32 {
-	if x != nil && x.Constant != nil {
-		return *x.Constant
+func (x *CommandResult) GetAssignSkillPoints() *CommandStatus {
+	if x != nil {
+		return x.AssignSkillPoints
 	}
-	return 0
+	return nil
 }
 
-type SkillAttributes struct {
+type PlayUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Strength       *Attributes `protobuf:"bytes,1,opt,name=strength,proto3,oneof" json:"strength,omitempty"`
-	Dexterity      *Attributes `protobuf:"bytes,2,opt,name=dexterity,proto3,oneof" json:"dexterity,omitempty"`
-	Intelligence   *Attributes `protobuf:"bytes,3,opt,name=intelligence,proto3,oneof" json:"intelligence,omitempty"`
-	Willpower      *Attributes `protobuf:"bytes,4,opt,name=willpower,proto3,oneof" json:"willpower,omitempty"`
-	Constitution   *Attributes `protobuf:"bytes,5,opt,name=constitution,proto3,oneof" json:"constitution,omitempty"`
-	SlashResist    *Attributes `protobuf:"bytes,6,opt,name=slash_resist,json=slashResist,proto3,oneof" json:"slash_resist,omitempty"`
-	PierceResist   *Attributes `protobuf:"bytes,7,opt,name=pierce_resist,json=pierceResist,proto3,oneof" json:"pierce_resist,omitempty"`
-	FireResist     *Attributes `protobuf:"bytes,8,opt,name=fire_resist,json=fireResist,proto3,oneof" json:"fire_resist,omitempty"`
-	PoisonResist   *Attributes `protobuf:"bytes,9,opt,name=poison_resist,json=poisonResist,proto3,oneof" json:"poison_resist,omitempty"`
-	ElectricResist *Attributes `protobuf:"bytes,10,opt,name=electric_resist,json=electricResist,proto3,oneof" json:"electric_resist,omitempty"`
-	Life           *Attributes `protobuf:"bytes,11,opt,name=life,proto3,oneof" json:"life,omitempty"`
-	Stamina        *Attributes `protobuf:"bytes,12,opt,name=stamina,proto3,oneof" json:"stamina,omitempty"`
-	Mana           *Attributes `protobuf:"bytes,13,opt,name=mana,proto3,oneof" json:"mana,omitempty"`
-	Constant       *Attributes `protobuf:"bytes,14,opt,name=constant,proto3,oneof" json:"constant,omitempty"`
+	GameState *GameState             `protobuf:"bytes,1,opt,name=game_state,json=gameState,proto3,oneof" json:"game_state,omitempty"`
+	Statuses  []*CommandsBatchStatus `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
 }
 
-func (x *SkillAttributes) Reset() {
-	*x = SkillAttributes{}
+func (x *PlayUpdate) Reset() {
+	*x = PlayUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1633,13 +1966,13 @@ func (x *SkillAttributes) Reset() {
 	}
 }
 
-func (x *SkillAttributes) String() string {
+func (x *PlayUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillAttributes) ProtoMessage() {}
+func (*PlayUpdate) ProtoMessage() {}
 
-func (x *SkillAttributes) ProtoReflect() protoreflect.Message {
+func (x *PlayUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1651,136 +1984,101 @@ func (x *SkillAttributes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillAttributes.ProtoReflect.Descriptor instead.
-func (*SkillAttributes) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayUpdate.ProtoReflect.Descriptor instead.
+func (*PlayUpdate) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SkillAttributes) GetStrength() *Attributes {
-	if x != nil {
-		return x.Strength
-	}
-	return nil
-}
-
-func (x *SkillAttributes) GetDexterity() *Attributes {
-	if x != nil {
-		return x.Dexterity
-	}
-	return nil
-}
-
-func (x *SkillAttributes) GetIntelligence() *Attributes {
-	if x != nil {
-		return x.Intelligence
-	}
-	return nil
-}
-
-func (x *SkillAttributes) GetWillpower() *Attributes {
-	if x != nil {
-		return x.Willpower
-	}
-	return nil
-}
-
-func (x *SkillAttributes) GetConstitution() *Attributes {
+func (x *PlayUpdate) GetGameState() *GameState {
 	if x != nil {
-		return x.Constitution
+		return x.GameState
 	}
 	return nil
 }
 
-func (x *SkillAttributes) GetSlashResist() *Attributes {
+func (x *PlayUpdate) GetStatuses() []*CommandsBatchStatus {
 	if x != nil {
-		return x.SlashResist
+		return x.Statuses
 	}
 	return nil
 }
 
-func (x *SkillAttributes) GetPierceResist() *Attributes {
-	if x != nil {
-		return x.PierceResist
-	}
-	return nil
-}
+type CommandsForMonsters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SkillAttributes) GetFireResist() *Attributes {
-	if x != nil {
-		return x.FireResist
-	}
-	return nil
+	Commands map[string]*CommandsBatch `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SkillAttributes) GetPoisonResist() *Attributes {
-	if x != nil {
-		return x.PoisonResist
+func (x *CommandsForMonsters) Reset() {
+	*x = CommandsForMonsters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SkillAttributes) GetElectricResist() *Attributes {
-	if x != nil {
-		return x.ElectricResist
-	}
-	return nil
+func (x *CommandsForMonsters) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SkillAttributes) GetLife() *Attributes {
-	if x != nil {
-		return x.Life
-	}
-	return nil
-}
+func (*CommandsForMonsters) ProtoMessage() {}
 
-func (x *SkillAttributes) GetStamina() *Attributes {
-	if x != nil {
-		return x.Stamina
+func (x *CommandsForMonsters) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SkillAttributes) GetMana() *Attributes {
-	if x != nil {
-		return x.Mana
-	}
-	return nil
+// Deprecated: Use CommandsForMonsters.ProtoReflect.Descriptor instead.
+func (*CommandsForMonsters) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SkillAttributes) GetConstant() *Attributes {
+func (x *CommandsForMonsters) GetCommands() map[string]*CommandsBatch {
 	if x != nil {
-		return x.Constant
+		return x.Commands
 	}
 	return nil
 }
 
-type Stats struct {
+type Effect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Life    float32 `protobuf:"fixed32,1,opt,name=life,proto3" json:"life,omitempty"`
-	Mana    float32 `protobuf:"fixed32,2,opt,name=mana,proto3" json:"mana,omitempty"`
-	Stamina float32 `protobuf:"fixed32,3,opt,name=stamina,proto3" json:"stamina,omitempty"`
+	DamageAmount float32     `protobuf:"fixed32,1,opt,name=damage_amount,json=damageAmount,proto3" json:"damage_amount,omitempty"`
+	DamageType   DamageType  `protobuf:"varint,2,opt,name=damage_type,json=damageType,proto3,enum=dungeonsandtrolls.DamageType" json:"damage_type,omitempty"`
+	Effects      *Attributes `protobuf:"bytes,3,opt,name=effects,proto3" json:"effects,omitempty"`
+	Duration     int32       `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	XCasterId    *string     `protobuf:"bytes,5,opt,name=_caster_id,json=CasterId,proto3,oneof" json:"_caster_id,omitempty"`
 }
 
-func (x *Stats) Reset() {
-	*x = Stats{}
+func (x *Effect) Reset() {
+	*x = Effect{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[23]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Stats) String() string {
+func (x *Effect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stats) ProtoMessage() {}
+func (*Effect) ProtoMessage() {}
 
-func (x *Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[23]
+func (x *Effect) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,58 +2089,84 @@ func (x *Stats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
-func (*Stats) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Effect.ProtoReflect.Descriptor instead.
+func (*Effect) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Stats) GetLife() float32 {
+func (x *Effect) GetDamageAmount() float32 {
 	if x != nil {
-		return x.Life
+		return x.DamageAmount
 	}
 	return 0
 }
 
-func (x *Stats) GetMana() float32 {
+func (x *Effect) GetDamageType() DamageType {
 	if x != nil {
-		return x.Mana
+		return x.DamageType
 	}
-	return 0
+	return DamageType_none
 }
 
-func (x *Stats) GetStamina() float32 {
+func (x *Effect) GetEffects() *Attributes {
 	if x != nil {
-		return x.Stamina
+		return x.Effects
+	}
+	return nil
+}
+
+func (x *Effect) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
 	}
 	return 0
 }
 
-type Stun struct {
+func (x *Effect) GetXCasterId() string {
+	if x != nil && x.XCasterId != nil {
+		return *x.XCasterId
+	}
+	return ""
+}
+
+type Attributes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsStunned bool `protobuf:"varint,1,opt,name=is_stunned,json=isStunned,proto3" json:"is_stunned,omitempty"`
-	IsImmune  bool `protobuf:"varint,2,opt,name=is_immune,json=isImmune,proto3" json:"is_immune,omitempty"`
+	Strength       *float32 `protobuf:"fixed32,1,opt,name=strength,proto3,oneof" json:"strength,omitempty"`
+	Dexterity      *float32 `protobuf:"fixed32,2,opt,name=dexterity,proto3,oneof" json:"dexterity,omitempty"`
+	Intelligence   *float32 `protobuf:"fixed32,3,opt,name=intelligence,proto3,oneof" json:"intelligence,omitempty"`
+	Willpower      *float32 `protobuf:"fixed32,4,opt,name=willpower,proto3,oneof" json:"willpower,omitempty"`
+	Constitution   *float32 `protobuf:"fixed32,5,opt,name=constitution,proto3,oneof" json:"constitution,omitempty"`
+	SlashResist    *float32 `protobuf:"fixed32,6,opt,name=slash_resist,json=slashResist,proto3,oneof" json:"slash_resist,omitempty"`
+	PierceResist   *float32 `protobuf:"fixed32,7,opt,name=pierce_resist,json=pierceResist,proto3,oneof" json:"pierce_resist,omitempty"`
+	FireResist     *float32 `protobuf:"fixed32,8,opt,name=fire_resist,json=fireResist,proto3,oneof" json:"fire_resist,omitempty"`
+	PoisonResist   *float32 `protobuf:"fixed32,9,opt,name=poison_resist,json=poisonResist,proto3,oneof" json:"poison_resist,omitempty"`
+	ElectricResist *float32 `protobuf:"fixed32,10,opt,name=electric_resist,json=electricResist,proto3,oneof" json:"electric_resist,omitempty"`
+	Life           *float32 `protobuf:"fixed32,11,opt,name=life,proto3,oneof" json:"life,omitempty"`
+	Stamina        *float32 `protobuf:"fixed32,12,opt,name=stamina,proto3,oneof" json:"stamina,omitempty"`
+	Mana           *float32 `protobuf:"fixed32,13,opt,name=mana,proto3,oneof" json:"mana,omitempty"`
+	Constant       *float32 `protobuf:"fixed32,14,opt,name=constant,proto3,oneof" json:"constant,omitempty"`
 }
 
-func (x *Stun) Reset() {
-	*x = Stun{}
+func (x *Attributes) Reset() {
+	*x = Attributes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[24]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Stun) String() string {
+func (x *Attributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stun) ProtoMessage() {}
+func (*Attributes) ProtoMessage() {}
 
-func (x *Stun) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[24]
+func (x *Attributes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,64 +2177,147 @@ func (x *Stun) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stun.ProtoReflect.Descriptor instead.
-func (*Stun) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Attributes.ProtoReflect.Descriptor instead.
+func (*Attributes) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Stun) GetIsStunned() bool {
-	if x != nil {
-		return x.IsStunned
+func (x *Attributes) GetStrength() float32 {
+	if x != nil && x.Strength != nil {
+		return *x.Strength
 	}
-	return false
+	return 0
 }
 
-func (x *Stun) GetIsImmune() bool {
-	if x != nil {
-		return x.IsImmune
+func (x *Attributes) GetDexterity() float32 {
+	if x != nil && x.Dexterity != nil {
+		return *x.Dexterity
 	}
-	return false
+	return 0
 }
 
-type Monster struct {
+func (x *Attributes) GetIntelligence() float32 {
+	if x != nil && x.Intelligence != nil {
+		return *x.Intelligence
+	}
+	return 0
+}
+
+func (x *Attributes) GetWillpower() float32 {
+	if x != nil && x.Willpower != nil {
+		return *x.Willpower
+	}
+	return 0
+}
+
+func (x *Attributes) GetConstitution() float32 {
+	if x != nil && x.Constitution != nil {
+		return *x.Constitution
+	}
+	return 0
+}
+
+func (x *Attributes) GetSlashResist() float32 {
+	if x != nil && x.SlashResist != nil {
+		return *x.SlashResist
+	}
+	return 0
+}
+
+func (x *Attributes) GetPierceResist() float32 {
+	if x != nil && x.PierceResist != nil {
+		return *x.PierceResist
+	}
+	return 0
+}
+
+func (x *Attributes) GetFireResist() float32 {
+	if x != nil && x.FireResist != nil {
+		return *x.FireResist
+	}
+	return 0
+}
+
+func (x *Attributes) GetPoisonResist() float32 {
+	if x != nil && x.PoisonResist != nil {
+		return *x.PoisonResist
+	}
+	return 0
+}
+
+func (x *Attributes) GetElectricResist() float32 {
+	if x != nil && x.ElectricResist != nil {
+		return *x.ElectricResist
+	}
+	return 0
+}
+
+func (x *Attributes) GetLife() float32 {
+	if x != nil && x.Life != nil {
+		return *x.Life
+	}
+	return 0
+}
+
+func (x *Attributes) GetStamina() float32 {
+	if x != nil && x.Stamina != nil {
+		return *x.Stamina
+	}
+	return 0
+}
+
+func (x *Attributes) GetMana() float32 {
+	if x != nil && x.Mana != nil {
+		return *x.Mana
+	}
+	return 0
+}
+
+func (x *Attributes) GetConstant() float32 {
+	if x != nil && x.Constant != nil {
+		return *x.Constant
+	}
+	return 0
+}
+
+type SkillAttributes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Icon            string        `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	Items           []*SimpleItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
-	Effects         []*Effect     `protobuf:"bytes,5,rep,name=effects,proto3" json:"effects,omitempty"`
-	LifePercentage  float32       `protobuf:"fixed32,6,opt,name=life_percentage,json=lifePercentage,proto3" json:"life_percentage,omitempty"`
-	Faction         string        `protobuf:"bytes,7,opt,name=faction,proto3" json:"faction,omitempty"`
-	Attributes      *Attributes   `protobuf:"bytes,8,opt,name=attributes,proto3,oneof" json:"attributes,omitempty"`
-	EquippedItems   []*Item       `protobuf:"bytes,9,rep,name=equipped_items,json=equippedItems,proto3" json:"equipped_items,omitempty"`
-	Score           *float32      `protobuf:"fixed32,10,opt,name=score,proto3,oneof" json:"score,omitempty"`
-	Algorithm       *string       `protobuf:"bytes,11,opt,name=algorithm,proto3,oneof" json:"algorithm,omitempty"`
-	OnDeath         []*Droppable  `protobuf:"bytes,12,rep,name=on_death,json=onDeath,proto3" json:"on_death,omitempty"`
-	MaxAttributes   *Attributes   `protobuf:"bytes,13,opt,name=max_attributes,json=maxAttributes,proto3,oneof" json:"max_attributes,omitempty"`
-	LastDamageTaken *int32        `protobuf:"varint,14,opt,name=last_damage_taken,json=lastDamageTaken,proto3,oneof" json:"last_damage_taken,omitempty"`
-	Stun            *Stun         `protobuf:"bytes,15,opt,name=stun,proto3,oneof" json:"stun,omitempty"`
+	Strength       *Attributes `protobuf:"bytes,1,opt,name=strength,proto3,oneof" json:"strength,omitempty"`
+	Dexterity      *Attributes `protobuf:"bytes,2,opt,name=dexterity,proto3,oneof" json:"dexterity,omitempty"`
+	Intelligence   *Attributes `protobuf:"bytes,3,opt,name=intelligence,proto3,oneof" json:"intelligence,omitempty"`
+	Willpower      *Attributes `protobuf:"bytes,4,opt,name=willpower,proto3,oneof" json:"willpower,omitempty"`
+	Constitution   *Attributes `protobuf:"bytes,5,opt,name=constitution,proto3,oneof" json:"constitution,omitempty"`
+	SlashResist    *Attributes `protobuf:"bytes,6,opt,name=slash_resist,json=slashResist,proto3,oneof" json:"slash_resist,omitempty"`
+	PierceResist   *Attributes `protobuf:"bytes,7,opt,name=pierce_resist,json=pierceResist,proto3,oneof" json:"pierce_resist,omitempty"`
+	FireResist     *Attributes `protobuf:"bytes,8,opt,name=fire_resist,json=fireResist,proto3,oneof" json:"fire_resist,omitempty"`
+	PoisonResist   *Attributes `protobuf:"bytes,9,opt,name=poison_resist,json=poisonResist,proto3,oneof" json:"poison_resist,omitempty"`
+	ElectricResist *Attributes `protobuf:"bytes,10,opt,name=electric_resist,json=electricResist,proto3,oneof" json:"electric_resist,omitempty"`
+	Life           *Attributes `protobuf:"bytes,11,opt,name=life,proto3,oneof" json:"life,omitempty"`
+	Stamina        *Attributes `protobuf:"bytes,12,opt,name=stamina,proto3,oneof" json:"stamina,omitempty"`
+	Mana           *Attributes `protobuf:"bytes,13,opt,name=mana,proto3,oneof" json:"mana,omitempty"`
+	Constant       *Attributes `protobuf:"bytes,14,opt,name=constant,proto3,oneof" json:"constant,omitempty"`
 }
 
-func (x *Monster) Reset() {
-	*x = Monster{}
+func (x *SkillAttributes) Reset() {
+	*x = SkillAttributes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[25]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Monster) String() string {
+func (x *SkillAttributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Monster) ProtoMessage() {}
+func (*SkillAttributes) ProtoMessage() {}
 
-func (x *Monster) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[25]
+func (x *SkillAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1921,152 +2328,136 @@ func (x *Monster) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Monster.ProtoReflect.Descriptor instead.
-func (*Monster) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SkillAttributes.ProtoReflect.Descriptor instead.
+func (*SkillAttributes) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Monster) GetId() string {
+func (x *SkillAttributes) GetStrength() *Attributes {
 	if x != nil {
-		return x.Id
+		return x.Strength
 	}
-	return ""
+	return nil
 }
 
-func (x *Monster) GetName() string {
+func (x *SkillAttributes) GetDexterity() *Attributes {
 	if x != nil {
-		return x.Name
+		return x.Dexterity
 	}
-	return ""
+	return nil
 }
 
-func (x *Monster) GetIcon() string {
+func (x *SkillAttributes) GetIntelligence() *Attributes {
 	if x != nil {
-		return x.Icon
+		return x.Intelligence
 	}
-	return ""
+	return nil
 }
 
-func (x *Monster) GetItems() []*SimpleItem {
+func (x *SkillAttributes) GetWillpower() *Attributes {
 	if x != nil {
-		return x.Items
+		return x.Willpower
 	}
 	return nil
 }
 
-func (x *Monster) GetEffects() []*Effect {
+func (x *SkillAttributes) GetConstitution() *Attributes {
 	if x != nil {
-		return x.Effects
+		return x.Constitution
 	}
 	return nil
 }
 
-func (x *Monster) GetLifePercentage() float32 {
+func (x *SkillAttributes) GetSlashResist() *Attributes {
 	if x != nil {
-		return x.LifePercentage
+		return x.SlashResist
 	}
-	return 0
+	return nil
 }
 
-func (x *Monster) GetFaction() string {
+func (x *SkillAttributes) GetPierceResist() *Attributes {
 	if x != nil {
-		return x.Faction
+		return x.PierceResist
 	}
-	return ""
+	return nil
 }
 
-func (x *Monster) GetAttributes() *Attributes {
+func (x *SkillAttributes) GetFireResist() *Attributes {
 	if x != nil {
-		return x.Attributes
+		return x.FireResist
 	}
 	return nil
 }
 
-func (x *Monster) GetEquippedItems() []*Item {
+func (x *SkillAttributes) GetPoisonResist() *Attributes {
 	if x != nil {
-		return x.EquippedItems
+		return x.PoisonResist
 	}
 	return nil
 }
 
-func (x *Monster) GetScore() float32 {
-	if x != nil && x.Score != nil {
-		return *x.Score
-	}
-	return 0
-}
-
-func (x *Monster) GetAlgorithm() string {
-	if x != nil && x.Algorithm != nil {
-		return *x.Algorithm
+func (x *SkillAttributes) GetElectricResist() *Attributes {
+	if x != nil {
+		return x.ElectricResist
 	}
-	return ""
+	return nil
 }
 
-func (x *Monster) GetOnDeath() []*Droppable {
+func (x *SkillAttributes) GetLife() *Attributes {
 	if x != nil {
-		return x.OnDeath
+		return x.Life
 	}
 	return nil
 }
 
-func (x *Monster) GetMaxAttributes() *Attributes {
+func (x *SkillAttributes) GetStamina() *Attributes {
 	if x != nil {
-		return x.MaxAttributes
+		return x.Stamina
 	}
 	return nil
 }
 
-func (x *Monster) GetLastDamageTaken() int32 {
-	if x != nil && x.LastDamageTaken != nil {
-		return *x.LastDamageTaken
+func (x *SkillAttributes) GetMana() *Attributes {
+	if x != nil {
+		return x.Mana
 	}
-	return 0
+	return nil
 }
 
-func (x *Monster) GetStun() *Stun {
+func (x *SkillAttributes) GetConstant() *Attributes {
 	if x != nil {
-		return x.Stun
+		return x.Constant
 	}
 	return nil
 }
 
-type Character struct {
+type Stats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Attributes      *Attributes  `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	Money           int32        `protobuf:"varint,4,opt,name=money,proto3" json:"money,omitempty"`
-	Equip           []*Item      `protobuf:"bytes,5,rep,name=equip,proto3" json:"equip,omitempty"`
-	Score           float32      `protobuf:"fixed32,6,opt,name=score,proto3" json:"score,omitempty"`
-	SkillPoints     float32      `protobuf:"fixed32,7,opt,name=skill_points,json=skillPoints,proto3" json:"skill_points,omitempty"`
-	Effects         []*Effect    `protobuf:"bytes,9,rep,name=effects,proto3" json:"effects,omitempty"`
-	MaxAttributes   *Attributes  `protobuf:"bytes,10,opt,name=max_attributes,json=maxAttributes,proto3" json:"max_attributes,omitempty"`
-	LastDamageTaken int32        `protobuf:"varint,11,opt,name=last_damage_taken,json=lastDamageTaken,proto3" json:"last_damage_taken,omitempty"`
-	Coordinates     *Coordinates `protobuf:"bytes,12,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
-	Stun            *Stun        `protobuf:"bytes,13,opt,name=stun,proto3" json:"stun,omitempty"`
+	Life    float32 `protobuf:"fixed32,1,opt,name=life,proto3" json:"life,omitempty"`
+	Mana    float32 `protobuf:"fixed32,2,opt,name=mana,proto3" json:"mana,omitempty"`
+	Stamina float32 `protobuf:"fixed32,3,opt,name=stamina,proto3" json:"stamina,omitempty"`
 }
 
-func (x *Character) Reset() {
-	*x = Character{}
+func (x *Stats) Reset() {
+	*x = Stats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[26]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Character) String() string {
+func (x *Stats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Character) ProtoMessage() {}
+func (*Stats) ProtoMessage() {}
 
-func (x *Character) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[26]
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,120 +2468,58 @@ func (x *Character) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Character.ProtoReflect.Descriptor instead.
-func (*Character) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *Character) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Character) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Character) GetAttributes() *Attributes {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-func (x *Character) GetMoney() int32 {
-	if x != nil {
-		return x.Money
-	}
-	return 0
-}
-
-func (x *Character) GetEquip() []*Item {
-	if x != nil {
-		return x.Equip
-	}
-	return nil
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Character) GetScore() float32 {
+func (x *Stats) GetLife() float32 {
 	if x != nil {
-		return x.Score
+		return x.Life
 	}
 	return 0
 }
 
-func (x *Character) GetSkillPoints() float32 {
+func (x *Stats) GetMana() float32 {
 	if x != nil {
-		return x.SkillPoints
+		return x.Mana
 	}
 	return 0
 }
 
-func (x *Character) GetEffects() []*Effect {
-	if x != nil {
-		return x.Effects
-	}
-	return nil
-}
-
-func (x *Character) GetMaxAttributes() *Attributes {
-	if x != nil {
-		return x.MaxAttributes
-	}
-	return nil
-}
-
-func (x *Character) GetLastDamageTaken() int32 {
+func (x *Stats) GetStamina() float32 {
 	if x != nil {
-		return x.LastDamageTaken
+		return x.Stamina
 	}
 	return 0
 }
 
-func (x *Character) GetCoordinates() *Coordinates {
-	if x != nil {
-		return x.Coordinates
-	}
-	return nil
-}
-
-func (x *Character) GetStun() *Stun {
-	if x != nil {
-		return x.Stun
-	}
-	return nil
-}
-
-type PlayersInfo struct {
+type Stun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Players []*Character `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	IsStunned bool `protobuf:"varint,1,opt,name=is_stunned,json=isStunned,proto3" json:"is_stunned,omitempty"`
+	IsImmune  bool `protobuf:"varint,2,opt,name=is_immune,json=isImmune,proto3" json:"is_immune,omitempty"`
 }
 
-func (x *PlayersInfo) Reset() {
-	*x = PlayersInfo{}
+func (x *Stun) Reset() {
+	*x = Stun{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[27]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlayersInfo) String() string {
+func (x *Stun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayersInfo) ProtoMessage() {}
+func (*Stun) ProtoMessage() {}
 
-func (x *PlayersInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[27]
+func (x *Stun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,54 +2530,64 @@ func (x *PlayersInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayersInfo.ProtoReflect.Descriptor instead.
-func (*PlayersInfo) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Stun.ProtoReflect.Descriptor instead.
+func (*Stun) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PlayersInfo) GetPlayers() []*Character {
+func (x *Stun) GetIsStunned() bool {
 	if x != nil {
-		return x.Players
+		return x.IsStunned
 	}
-	return nil
+	return false
 }
 
-type Skill struct {
+func (x *Stun) GetIsImmune() bool {
+	if x != nil {
+		return x.IsImmune
+	}
+	return false
+}
+
+type Monster struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Target        Skill_Target       `protobuf:"varint,3,opt,name=target,proto3,enum=dungeonsandtrolls.Skill_Target" json:"target,omitempty"`
-	Cost          *Attributes        `protobuf:"bytes,4,opt,name=cost,proto3" json:"cost,omitempty"`
-	Range         *Attributes        `protobuf:"bytes,5,opt,name=range,proto3" json:"range,omitempty"`
-	Radius        *Attributes        `protobuf:"bytes,6,opt,name=radius,proto3" json:"radius,omitempty"`
-	Duration      *Attributes        `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
-	DamageAmount  *Attributes        `protobuf:"bytes,8,opt,name=damage_amount,json=damageAmount,proto3" json:"damage_amount,omitempty"`
-	DamageType    DamageType         `protobuf:"varint,9,opt,name=damage_type,json=damageType,proto3,enum=dungeonsandtrolls.DamageType" json:"damage_type,omitempty"`
-	CasterEffects *SkillEffect       `protobuf:"bytes,10,opt,name=caster_effects,json=casterEffects,proto3" json:"caster_effects,omitempty"`
-	TargetEffects *SkillEffect       `protobuf:"bytes,11,opt,name=target_effects,json=targetEffects,proto3" json:"target_effects,omitempty"`
-	Flags         *SkillGenericFlags `protobuf:"bytes,12,opt,name=flags,proto3" json:"flags,omitempty"`
+	Id              string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon            string        `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Items           []*SimpleItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Effects         []*Effect     `protobuf:"bytes,5,rep,name=effects,proto3" json:"effects,omitempty"`
+	LifePercentage  float32       `protobuf:"fixed32,6,opt,name=life_percentage,json=lifePercentage,proto3" json:"life_percentage,omitempty"`
+	Faction         string        `protobuf:"bytes,7,opt,name=faction,proto3" json:"faction,omitempty"`
+	Attributes      *Attributes   `protobuf:"bytes,8,opt,name=attributes,proto3,oneof" json:"attributes,omitempty"`
+	EquippedItems   []*Item       `protobuf:"bytes,9,rep,name=equipped_items,json=equippedItems,proto3" json:"equipped_items,omitempty"`
+	Score           *float32      `protobuf:"fixed32,10,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Algorithm       *string       `protobuf:"bytes,11,opt,name=algorithm,proto3,oneof" json:"algorithm,omitempty"`
+	OnDeath         []*Droppable  `protobuf:"bytes,12,rep,name=on_death,json=onDeath,proto3" json:"on_death,omitempty"`
+	MaxAttributes   *Attributes   `protobuf:"bytes,13,opt,name=max_attributes,json=maxAttributes,proto3,oneof" json:"max_attributes,omitempty"`
+	LastDamageTaken *int32        `protobuf:"varint,14,opt,name=last_damage_taken,json=lastDamageTaken,proto3,oneof" json:"last_damage_taken,omitempty"`
+	Stun            *Stun         `protobuf:"bytes,15,opt,name=stun,proto3,oneof" json:"stun,omitempty"`
 }
 
-func (x *Skill) Reset() {
-	*x = Skill{}
+func (x *Monster) Reset() {
+	*x = Monster{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[28]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Skill) String() string {
+func (x *Monster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Skill) ProtoMessage() {}
+func (*Monster) ProtoMessage() {}
 
-func (x *Skill) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[28]
+func (x *Monster) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2259,128 +2598,152 @@ func (x *Skill) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Skill.ProtoReflect.Descriptor instead.
-func (*Skill) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use Monster.ProtoReflect.Descriptor instead.
+func (*Monster) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Skill) GetId() string {
+func (x *Monster) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Skill) GetName() string {
+func (x *Monster) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Skill) GetTarget() Skill_Target {
+func (x *Monster) GetIcon() string {
 	if x != nil {
-		return x.Target
+		return x.Icon
 	}
-	return Skill_none
+	return ""
 }
 
-func (x *Skill) GetCost() *Attributes {
+func (x *Monster) GetItems() []*SimpleItem {
 	if x != nil {
-		return x.Cost
+		return x.Items
 	}
 	return nil
 }
 
-func (x *Skill) GetRange() *Attributes {
+func (x *Monster) GetEffects() []*Effect {
 	if x != nil {
-		return x.Range
+		return x.Effects
 	}
 	return nil
 }
 
-func (x *Skill) GetRadius() *Attributes {
+func (x *Monster) GetLifePercentage() float32 {
 	if x != nil {
-		return x.Radius
+		return x.LifePercentage
 	}
-	return nil
+	return 0
 }
 
-func (x *Skill) GetDuration() *Attributes {
+func (x *Monster) GetFaction() string {
 	if x != nil {
-		return x.Duration
+		return x.Faction
 	}
-	return nil
+	return ""
 }
 
-func (x *Skill) GetDamageAmount() *Attributes {
+func (x *Monster) GetAttributes() *Attributes {
 	if x != nil {
-		return x.DamageAmount
+		return x.Attributes
 	}
 	return nil
 }
 
-func (x *Skill) GetDamageType() DamageType {
+func (x *Monster) GetEquippedItems() []*Item {
 	if x != nil {
-		return x.DamageType
+		return x.EquippedItems
 	}
-	return DamageType_none
+	return nil
 }
 
-func (x *Skill) GetCasterEffects() *SkillEffect {
+func (x *Monster) GetScore() float32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *Monster) GetAlgorithm() string {
+	if x != nil && x.Algorithm != nil {
+		return *x.Algorithm
+	}
+	return ""
+}
+
+func (x *Monster) GetOnDeath() []*Droppable {
 	if x != nil {
-		return x.CasterEffects
+		return x.OnDeath
 	}
 	return nil
 }
 
-func (x *Skill) GetTargetEffects() *SkillEffect {
+func (x *Monster) GetMaxAttributes() *Attributes {
 	if x != nil {
-		return x.TargetEffects
+		return x.MaxAttributes
 	}
 	return nil
 }
 
-func (x *Skill) GetFlags() *SkillGenericFlags {
+func (x *Monster) GetLastDamageTaken() int32 {
+	if x != nil && x.LastDamageTaken != nil {
+		return *x.LastDamageTaken
+	}
+	return 0
+}
+
+func (x *Monster) GetStun() *Stun {
 	if x != nil {
-		return x.Flags
+		return x.Stun
 	}
 	return nil
 }
 
-type Item struct {
+type Character struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Slot         Item_Type   `protobuf:"varint,3,opt,name=slot,proto3,enum=dungeonsandtrolls.Item_Type" json:"slot,omitempty"`
-	Price        int32       `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Requirements *Attributes `protobuf:"bytes,5,opt,name=requirements,proto3" json:"requirements,omitempty"`
-	Attributes   *Attributes `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	Skills       []*Skill    `protobuf:"bytes,7,rep,name=skills,proto3" json:"skills,omitempty"`
-	Icon         string      `protobuf:"bytes,8,opt,name=icon,proto3" json:"icon,omitempty"`
-	Unidentified *bool       `protobuf:"varint,9,opt,name=unidentified,proto3,oneof" json:"unidentified,omitempty"`
+	Id              string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Attributes      *Attributes  `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Money           int32        `protobuf:"varint,4,opt,name=money,proto3" json:"money,omitempty"`
+	Equip           []*Item      `protobuf:"bytes,5,rep,name=equip,proto3" json:"equip,omitempty"`
+	Score           float32      `protobuf:"fixed32,6,opt,name=score,proto3" json:"score,omitempty"`
+	SkillPoints     float32      `protobuf:"fixed32,7,opt,name=skill_points,json=skillPoints,proto3" json:"skill_points,omitempty"`
+	Effects         []*Effect    `protobuf:"bytes,9,rep,name=effects,proto3" json:"effects,omitempty"`
+	MaxAttributes   *Attributes  `protobuf:"bytes,10,opt,name=max_attributes,json=maxAttributes,proto3" json:"max_attributes,omitempty"`
+	LastDamageTaken int32        `protobuf:"varint,11,opt,name=last_damage_taken,json=lastDamageTaken,proto3" json:"last_damage_taken,omitempty"`
+	Coordinates     *Coordinates `protobuf:"bytes,12,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Stun            *Stun        `protobuf:"bytes,13,opt,name=stun,proto3" json:"stun,omitempty"`
 }
 
-func (x *Item) Reset() {
-	*x = Item{}
+func (x *Character) Reset() {
+	*x = Character{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[29]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Item) String() string {
+func (x *Character) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Item) ProtoMessage() {}
+func (*Character) ProtoMessage() {}
 
-func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[29]
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2391,101 +2754,120 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Item.ProtoReflect.Descriptor instead.
-func (*Item) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Item) GetId() string {
+func (x *Character) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Item) GetName() string {
+func (x *Character) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Item) GetSlot() Item_Type {
+func (x *Character) GetAttributes() *Attributes {
 	if x != nil {
-		return x.Slot
+		return x.Attributes
 	}
-	return Item_head
+	return nil
 }
 
-func (x *Item) GetPrice() int32 {
+func (x *Character) GetMoney() int32 {
 	if x != nil {
-		return x.Price
+		return x.Money
 	}
 	return 0
 }
 
-func (x *Item) GetRequirements() *Attributes {
+func (x *Character) GetEquip() []*Item {
 	if x != nil {
-		return x.Requirements
+		return x.Equip
 	}
 	return nil
 }
 
-func (x *Item) GetAttributes() *Attributes {
+func (x *Character) GetScore() float32 {
 	if x != nil {
-		return x.Attributes
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Character) GetSkillPoints() float32 {
+	if x != nil {
+		return x.SkillPoints
+	}
+	return 0
+}
+
+func (x *Character) GetEffects() []*Effect {
+	if x != nil {
+		return x.Effects
 	}
 	return nil
 }
 
-func (x *Item) GetSkills() []*Skill {
+func (x *Character) GetMaxAttributes() *Attributes {
 	if x != nil {
-		return x.Skills
+		return x.MaxAttributes
 	}
 	return nil
 }
 
-func (x *Item) GetIcon() string {
+func (x *Character) GetLastDamageTaken() int32 {
 	if x != nil {
-		return x.Icon
+		return x.LastDamageTaken
 	}
-	return ""
+	return 0
 }
 
-func (x *Item) GetUnidentified() bool {
-	if x != nil && x.Unidentified != nil {
-		return *x.Unidentified
+func (x *Character) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
 	}
-	return false
+	return nil
 }
 
-type SimpleItem struct {
+func (x *Character) GetStun() *Stun {
+	if x != nil {
+		return x.Stun
+	}
+	return nil
+}
+
+type PlayersInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Slot Item_Type `protobuf:"varint,2,opt,name=slot,proto3,enum=dungeonsandtrolls.Item_Type" json:"slot,omitempty"`
-	Icon string    `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Players []*Character `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
 }
 
-func (x *SimpleItem) Reset() {
-	*x = SimpleItem{}
+func (x *PlayersInfo) Reset() {
+	*x = PlayersInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[30]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimpleItem) String() string {
+func (x *PlayersInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimpleItem) ProtoMessage() {}
+func (*PlayersInfo) ProtoMessage() {}
 
-func (x *SimpleItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[30]
+func (x *PlayersInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,65 +2878,54 @@ func (x *SimpleItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimpleItem.ProtoReflect.Descriptor instead.
-func (*SimpleItem) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *SimpleItem) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SimpleItem) GetSlot() Item_Type {
-	if x != nil {
-		return x.Slot
-	}
-	return Item_head
+// Deprecated: Use PlayersInfo.ProtoReflect.Descriptor instead.
+func (*PlayersInfo) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SimpleItem) GetIcon() string {
+func (x *PlayersInfo) GetPlayers() []*Character {
 	if x != nil {
-		return x.Icon
+		return x.Players
 	}
-	return ""
+	return nil
 }
 
-type Droppable struct {
+type Skill struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//
-	//	*Droppable_Skill
-	//	*Droppable_Item
-	//	*Droppable_Monster
-	//	*Droppable_Decoration
-	//	*Droppable_Waypoint
-	//	*Droppable_Key
-	Data isDroppable_Data `protobuf_oneof:"data"`
+	Id            string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Target        Skill_Target       `protobuf:"varint,3,opt,name=target,proto3,enum=dungeonsandtrolls.Skill_Target" json:"target,omitempty"`
+	Cost          *Attributes        `protobuf:"bytes,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Range         *Attributes        `protobuf:"bytes,5,opt,name=range,proto3" json:"range,omitempty"`
+	Radius        *Attributes        `protobuf:"bytes,6,opt,name=radius,proto3" json:"radius,omitempty"`
+	Duration      *Attributes        `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	DamageAmount  *Attributes        `protobuf:"bytes,8,opt,name=damage_amount,json=damageAmount,proto3" json:"damage_amount,omitempty"`
+	DamageType    DamageType         `protobuf:"varint,9,opt,name=damage_type,json=damageType,proto3,enum=dungeonsandtrolls.DamageType" json:"damage_type,omitempty"`
+	CasterEffects *SkillEffect       `protobuf:"bytes,10,opt,name=caster_effects,json=casterEffects,proto3" json:"caster_effects,omitempty"`
+	TargetEffects *SkillEffect       `protobuf:"bytes,11,opt,name=target_effects,json=targetEffects,proto3" json:"target_effects,omitempty"`
+	Flags         *SkillGenericFlags `protobuf:"bytes,12,opt,name=flags,proto3" json:"flags,omitempty"`
 }
 
-func (x *Droppable) Reset() {
-	*x = Droppable{}
+func (x *Skill) Reset() {
+	*x = Skill{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[31]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Droppable) String() string {
+func (x *Skill) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Droppable) ProtoMessage() {}
+func (*Skill) ProtoMessage() {}
 
-func (x *Droppable) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[31]
+func (x *Skill) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2565,127 +2936,128 @@ func (x *Droppable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Droppable.ProtoReflect.Descriptor instead.
-func (*Droppable) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Skill.ProtoReflect.Descriptor instead.
+func (*Skill) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{32}
 }
 
-func (m *Droppable) GetData() isDroppable_Data {
-	if m != nil {
-		return m.Data
+func (x *Skill) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Droppable) GetSkill() *Skill {
-	if x, ok := x.GetData().(*Droppable_Skill); ok {
-		return x.Skill
-	}
-	return nil
+func (x *Skill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *Droppable) GetItem() *Item {
-	if x, ok := x.GetData().(*Droppable_Item); ok {
-		return x.Item
+func (x *Skill) GetTarget() Skill_Target {
+	if x != nil {
+		return x.Target
 	}
-	return nil
+	return Skill_none
 }
 
-func (x *Droppable) GetMonster() *Monster {
-	if x, ok := x.GetData().(*Droppable_Monster); ok {
-		return x.Monster
+func (x *Skill) GetCost() *Attributes {
+	if x != nil {
+		return x.Cost
 	}
 	return nil
 }
 
-func (x *Droppable) GetDecoration() *Decoration {
-	if x, ok := x.GetData().(*Droppable_Decoration); ok {
-		return x.Decoration
+func (x *Skill) GetRange() *Attributes {
+	if x != nil {
+		return x.Range
 	}
 	return nil
 }
 
-func (x *Droppable) GetWaypoint() *Waypoint {
-	if x, ok := x.GetData().(*Droppable_Waypoint); ok {
-		return x.Waypoint
+func (x *Skill) GetRadius() *Attributes {
+	if x != nil {
+		return x.Radius
 	}
 	return nil
 }
 
-func (x *Droppable) GetKey() *Key {
-	if x, ok := x.GetData().(*Droppable_Key); ok {
-		return x.Key
+func (x *Skill) GetDuration() *Attributes {
+	if x != nil {
+		return x.Duration
 	}
 	return nil
 }
 
-type isDroppable_Data interface {
-	isDroppable_Data()
-}
-
-type Droppable_Skill struct {
-	Skill *Skill `protobuf:"bytes,1,opt,name=skill,proto3,oneof"`
-}
-
-type Droppable_Item struct {
-	Item *Item `protobuf:"bytes,2,opt,name=item,proto3,oneof"`
+func (x *Skill) GetDamageAmount() *Attributes {
+	if x != nil {
+		return x.DamageAmount
+	}
+	return nil
 }
 
-type Droppable_Monster struct {
-	Monster *Monster `protobuf:"bytes,3,opt,name=monster,proto3,oneof"`
+func (x *Skill) GetDamageType() DamageType {
+	if x != nil {
+		return x.DamageType
+	}
+	return DamageType_none
 }
 
-type Droppable_Decoration struct {
-	Decoration *Decoration `protobuf:"bytes,4,opt,name=decoration,proto3,oneof"`
+func (x *Skill) GetCasterEffects() *SkillEffect {
+	if x != nil {
+		return x.CasterEffects
+	}
+	return nil
 }
 
-type Droppable_Waypoint struct {
-	Waypoint *Waypoint `protobuf:"bytes,5,opt,name=waypoint,proto3,oneof"`
+func (x *Skill) GetTargetEffects() *SkillEffect {
+	if x != nil {
+		return x.TargetEffects
+	}
+	return nil
 }
 
-type Droppable_Key struct {
-	Key *Key `protobuf:"bytes,6,opt,name=key,proto3,oneof"`
+func (x *Skill) GetFlags() *SkillGenericFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
 }
 
-func (*Droppable_Skill) isDroppable_Data() {}
-
-func (*Droppable_Item) isDroppable_Data() {}
-
-func (*Droppable_Monster) isDroppable_Data() {}
-
-func (*Droppable_Decoration) isDroppable_Data() {}
-
-func (*Droppable_Waypoint) isDroppable_Data() {}
-
-func (*Droppable_Key) isDroppable_Data() {}
-
-type SkillGenericFlags struct {
+type Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequiresOutOfCombat bool `protobuf:"varint,1,opt,name=requires_out_of_combat,json=requiresOutOfCombat,proto3" json:"requires_out_of_combat,omitempty"`
-	RequiresLineOfSight bool `protobuf:"varint,2,opt,name=requires_line_of_sight,json=requiresLineOfSight,proto3" json:"requires_line_of_sight,omitempty"`
-	Passive             bool `protobuf:"varint,3,opt,name=passive,proto3" json:"passive,omitempty"`
+	Id           string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slot         Item_Type   `protobuf:"varint,3,opt,name=slot,proto3,enum=dungeonsandtrolls.Item_Type" json:"slot,omitempty"`
+	Price        int32       `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Requirements *Attributes `protobuf:"bytes,5,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	Attributes   *Attributes `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Skills       []*Skill    `protobuf:"bytes,7,rep,name=skills,proto3" json:"skills,omitempty"`
+	Icon         string      `protobuf:"bytes,8,opt,name=icon,proto3" json:"icon,omitempty"`
+	Unidentified *bool       `protobuf:"varint,9,opt,name=unidentified,proto3,oneof" json:"unidentified,omitempty"`
 }
 
-func (x *SkillGenericFlags) Reset() {
-	*x = SkillGenericFlags{}
+func (x *Item) Reset() {
+	*x = Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[32]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SkillGenericFlags) String() string {
+func (x *Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillGenericFlags) ProtoMessage() {}
+func (*Item) ProtoMessage() {}
 
-func (x *SkillGenericFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[32]
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2696,115 +3068,86 @@ func (x *SkillGenericFlags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillGenericFlags.ProtoReflect.Descriptor instead.
-func (*SkillGenericFlags) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SkillGenericFlags) GetRequiresOutOfCombat() bool {
+func (x *Item) GetId() string {
 	if x != nil {
-		return x.RequiresOutOfCombat
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *SkillGenericFlags) GetRequiresLineOfSight() bool {
+func (x *Item) GetName() string {
 	if x != nil {
-		return x.RequiresLineOfSight
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *SkillGenericFlags) GetPassive() bool {
+func (x *Item) GetSlot() Item_Type {
 	if x != nil {
-		return x.Passive
+		return x.Slot
 	}
-	return false
-}
-
-type SkillSpecificFlags struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Movement     bool `protobuf:"varint,1,opt,name=movement,proto3" json:"movement,omitempty"`
-	Knockback    bool `protobuf:"varint,2,opt,name=knockback,proto3" json:"knockback,omitempty"`
-	Stun         bool `protobuf:"varint,3,opt,name=stun,proto3" json:"stun,omitempty"`
-	GroundEffect bool `protobuf:"varint,4,opt,name=ground_effect,json=groundEffect,proto3" json:"ground_effect,omitempty"`
+	return Item_head
 }
 
-func (x *SkillSpecificFlags) Reset() {
-	*x = SkillSpecificFlags{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Item) GetPrice() int32 {
+	if x != nil {
+		return x.Price
 	}
+	return 0
 }
 
-func (x *SkillSpecificFlags) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SkillSpecificFlags) ProtoMessage() {}
-
-func (x *SkillSpecificFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Item) GetRequirements() *Attributes {
+	if x != nil {
+		return x.Requirements
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SkillSpecificFlags.ProtoReflect.Descriptor instead.
-func (*SkillSpecificFlags) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{33}
+	return nil
 }
 
-func (x *SkillSpecificFlags) GetMovement() bool {
+func (x *Item) GetAttributes() *Attributes {
 	if x != nil {
-		return x.Movement
+		return x.Attributes
 	}
-	return false
+	return nil
 }
 
-func (x *SkillSpecificFlags) GetKnockback() bool {
+func (x *Item) GetSkills() []*Skill {
 	if x != nil {
-		return x.Knockback
+		return x.Skills
 	}
-	return false
+	return nil
 }
 
-func (x *SkillSpecificFlags) GetStun() bool {
+func (x *Item) GetIcon() string {
 	if x != nil {
-		return x.Stun
+		return x.Icon
 	}
-	return false
+	return ""
 }
 
-func (x *SkillSpecificFlags) GetGroundEffect() bool {
-	if x != nil {
-		return x.GroundEffect
+func (x *Item) GetUnidentified() bool {
+	if x != nil && x.Unidentified != nil {
+		return *x.Unidentified
 	}
 	return false
 }
 
-type SkillEffect struct {
+type SimpleItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Attributes *SkillAttributes    `protobuf:"bytes,1,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	Flags      *SkillSpecificFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
-	Summons    []*Droppable        `protobuf:"bytes,3,rep,name=summons,proto3" json:"summons,omitempty"`
+	Name string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slot Item_Type `protobuf:"varint,2,opt,name=slot,proto3,enum=dungeonsandtrolls.Item_Type" json:"slot,omitempty"`
+	Icon string    `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
 }
 
-func (x *SkillEffect) Reset() {
-	*x = SkillEffect{}
+func (x *SimpleItem) Reset() {
+	*x = SimpleItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2812,13 +3155,13 @@ func (x *SkillEffect) Reset() {
 	}
 }
 
-func (x *SkillEffect) String() string {
+func (x *SimpleItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillEffect) ProtoMessage() {}
+func (*SimpleItem) ProtoMessage() {}
 
-func (x *SkillEffect) ProtoReflect() protoreflect.Message {
+func (x *SimpleItem) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,42 +3173,50 @@ func (x *SkillEffect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillEffect.ProtoReflect.Descriptor instead.
-func (*SkillEffect) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimpleItem.ProtoReflect.Descriptor instead.
+func (*SimpleItem) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SkillEffect) GetAttributes() *SkillAttributes {
+func (x *SimpleItem) GetName() string {
 	if x != nil {
-		return x.Attributes
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *SkillEffect) GetFlags() *SkillSpecificFlags {
+func (x *SimpleItem) GetSlot() Item_Type {
 	if x != nil {
-		return x.Flags
+		return x.Slot
 	}
-	return nil
+	return Item_head
 }
 
-func (x *SkillEffect) GetSummons() []*Droppable {
+func (x *SimpleItem) GetIcon() string {
 	if x != nil {
-		return x.Summons
+		return x.Icon
 	}
-	return nil
+	return ""
 }
 
-type Shortcut struct {
+type Droppable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeadsTo *Coordinates `protobuf:"bytes,1,opt,name=leads_to,json=leadsTo,proto3,oneof" json:"leads_to,omitempty"`
+	// Types that are assignable to Data:
+	//
+	//	*Droppable_Skill
+	//	*Droppable_Item
+	//	*Droppable_Monster
+	//	*Droppable_Decoration
+	//	*Droppable_Waypoint
+	//	*Droppable_Key
+	Data isDroppable_Data `protobuf_oneof:"data"`
 }
 
-func (x *Shortcut) Reset() {
-	*x = Shortcut{}
+func (x *Droppable) Reset() {
+	*x = Droppable{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2873,13 +3224,13 @@ func (x *Shortcut) Reset() {
 	}
 }
 
-func (x *Shortcut) String() string {
+func (x *Droppable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shortcut) ProtoMessage() {}
+func (*Droppable) ProtoMessage() {}
 
-func (x *Shortcut) ProtoReflect() protoreflect.Message {
+func (x *Droppable) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2891,39 +3242,112 @@ func (x *Shortcut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shortcut.ProtoReflect.Descriptor instead.
-func (*Shortcut) Descriptor() ([]byte, []int) {
+// Deprecated: Use Droppable.ProtoReflect.Descriptor instead.
+func (*Droppable) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Shortcut) GetLeadsTo() *Coordinates {
-	if x != nil {
-		return x.LeadsTo
+func (m *Droppable) GetData() isDroppable_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-type MapObjects struct {
+func (x *Droppable) GetSkill() *Skill {
+	if x, ok := x.GetData().(*Droppable_Skill); ok {
+		return x.Skill
+	}
+	return nil
+}
+
+func (x *Droppable) GetItem() *Item {
+	if x, ok := x.GetData().(*Droppable_Item); ok {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Droppable) GetMonster() *Monster {
+	if x, ok := x.GetData().(*Droppable_Monster); ok {
+		return x.Monster
+	}
+	return nil
+}
+
+func (x *Droppable) GetDecoration() *Decoration {
+	if x, ok := x.GetData().(*Droppable_Decoration); ok {
+		return x.Decoration
+	}
+	return nil
+}
+
+func (x *Droppable) GetWaypoint() *Waypoint {
+	if x, ok := x.GetData().(*Droppable_Waypoint); ok {
+		return x.Waypoint
+	}
+	return nil
+}
+
+func (x *Droppable) GetKey() *Key {
+	if x, ok := x.GetData().(*Droppable_Key); ok {
+		return x.Key
+	}
+	return nil
+}
+
+type isDroppable_Data interface {
+	isDroppable_Data()
+}
+
+type Droppable_Skill struct {
+	Skill *Skill `protobuf:"bytes,1,opt,name=skill,proto3,oneof"`
+}
+
+type Droppable_Item struct {
+	Item *Item `protobuf:"bytes,2,opt,name=item,proto3,oneof"`
+}
+
+type Droppable_Monster struct {
+	Monster *Monster `protobuf:"bytes,3,opt,name=monster,proto3,oneof"`
+}
+
+type Droppable_Decoration struct {
+	Decoration *Decoration `protobuf:"bytes,4,opt,name=decoration,proto3,oneof"`
+}
+
+type Droppable_Waypoint struct {
+	Waypoint *Waypoint `protobuf:"bytes,5,opt,name=waypoint,proto3,oneof"`
+}
+
+type Droppable_Key struct {
+	Key *Key `protobuf:"bytes,6,opt,name=key,proto3,oneof"`
+}
+
+func (*Droppable_Skill) isDroppable_Data() {}
+
+func (*Droppable_Item) isDroppable_Data() {}
+
+func (*Droppable_Monster) isDroppable_Data() {}
+
+func (*Droppable_Decoration) isDroppable_Data() {}
+
+func (*Droppable_Waypoint) isDroppable_Data() {}
+
+func (*Droppable_Key) isDroppable_Data() {}
+
+type SkillGenericFlags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position    *Position     `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	Monsters    []*Monster    `protobuf:"bytes,2,rep,name=monsters,proto3" json:"monsters,omitempty"`
-	Players     []*Character  `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
-	IsStairs    bool          `protobuf:"varint,4,opt,name=is_stairs,json=isStairs,proto3" json:"is_stairs,omitempty"`
-	Portal      *Waypoint     `protobuf:"bytes,5,opt,name=portal,proto3,oneof" json:"portal,omitempty"`
-	Decorations []*Decoration `protobuf:"bytes,6,rep,name=decorations,proto3" json:"decorations,omitempty"`
-	Effects     []*Effect     `protobuf:"bytes,7,rep,name=effects,proto3" json:"effects,omitempty"`
-	Items       []*Item       `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
-	IsFree      bool          `protobuf:"varint,9,opt,name=is_free,json=isFree,proto3" json:"is_free,omitempty"`
-	IsWall      bool          `protobuf:"varint,10,opt,name=is_wall,json=isWall,proto3" json:"is_wall,omitempty"`
-	IsDoor      bool          `protobuf:"varint,11,opt,name=is_door,json=isDoor,proto3" json:"is_door,omitempty"`
-	IsSpawn     *bool         `protobuf:"varint,12,opt,name=is_spawn,json=isSpawn,proto3,oneof" json:"is_spawn,omitempty"`
+	RequiresOutOfCombat bool `protobuf:"varint,1,opt,name=requires_out_of_combat,json=requiresOutOfCombat,proto3" json:"requires_out_of_combat,omitempty"`
+	RequiresLineOfSight bool `protobuf:"varint,2,opt,name=requires_line_of_sight,json=requiresLineOfSight,proto3" json:"requires_line_of_sight,omitempty"`
+	Passive             bool `protobuf:"varint,3,opt,name=passive,proto3" json:"passive,omitempty"`
 }
 
-func (x *MapObjects) Reset() {
-	*x = MapObjects{}
+func (x *SkillGenericFlags) Reset() {
+	*x = SkillGenericFlags{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2931,13 +3355,13 @@ func (x *MapObjects) Reset() {
 	}
 }
 
-func (x *MapObjects) String() string {
+func (x *SkillGenericFlags) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapObjects) ProtoMessage() {}
+func (*SkillGenericFlags) ProtoMessage() {}
 
-func (x *MapObjects) ProtoReflect() protoreflect.Message {
+func (x *SkillGenericFlags) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2949,127 +3373,130 @@ func (x *MapObjects) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapObjects.ProtoReflect.Descriptor instead.
-func (*MapObjects) Descriptor() ([]byte, []int) {
+// Deprecated: Use SkillGenericFlags.ProtoReflect.Descriptor instead.
+func (*SkillGenericFlags) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MapObjects) GetPosition() *Position {
+func (x *SkillGenericFlags) GetRequiresOutOfCombat() bool {
 	if x != nil {
-		return x.Position
+		return x.RequiresOutOfCombat
 	}
-	return nil
+	return false
 }
 
-func (x *MapObjects) GetMonsters() []*Monster {
+func (x *SkillGenericFlags) GetRequiresLineOfSight() bool {
 	if x != nil {
-		return x.Monsters
+		return x.RequiresLineOfSight
 	}
-	return nil
+	return false
 }
 
-func (x *MapObjects) GetPlayers() []*Character {
+func (x *SkillGenericFlags) GetPassive() bool {
 	if x != nil {
-		return x.Players
+		return x.Passive
 	}
-	return nil
+	return false
 }
 
-func (x *MapObjects) GetIsStairs() bool {
-	if x != nil {
-		return x.IsStairs
-	}
-	return false
+type SkillSpecificFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Movement     bool `protobuf:"varint,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	Knockback    bool `protobuf:"varint,2,opt,name=knockback,proto3" json:"knockback,omitempty"`
+	Stun         bool `protobuf:"varint,3,opt,name=stun,proto3" json:"stun,omitempty"`
+	GroundEffect bool `protobuf:"varint,4,opt,name=ground_effect,json=groundEffect,proto3" json:"ground_effect,omitempty"`
 }
 
-func (x *MapObjects) GetPortal() *Waypoint {
-	if x != nil {
-		return x.Portal
+func (x *SkillSpecificFlags) Reset() {
+	*x = SkillSpecificFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MapObjects) GetDecorations() []*Decoration {
-	if x != nil {
-		return x.Decorations
-	}
-	return nil
+func (x *SkillSpecificFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MapObjects) GetEffects() []*Effect {
-	if x != nil {
-		return x.Effects
+func (*SkillSpecificFlags) ProtoMessage() {}
+
+func (x *SkillSpecificFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MapObjects) GetItems() []*Item {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+// Deprecated: Use SkillSpecificFlags.ProtoReflect.Descriptor instead.
+func (*SkillSpecificFlags) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *MapObjects) GetIsFree() bool {
+func (x *SkillSpecificFlags) GetMovement() bool {
 	if x != nil {
-		return x.IsFree
+		return x.Movement
 	}
 	return false
 }
 
-func (x *MapObjects) GetIsWall() bool {
+func (x *SkillSpecificFlags) GetKnockback() bool {
 	if x != nil {
-		return x.IsWall
+		return x.Knockback
 	}
 	return false
 }
 
-func (x *MapObjects) GetIsDoor() bool {
+func (x *SkillSpecificFlags) GetStun() bool {
 	if x != nil {
-		return x.IsDoor
+		return x.Stun
 	}
 	return false
 }
 
-func (x *MapObjects) GetIsSpawn() bool {
-	if x != nil && x.IsSpawn != nil {
-		return *x.IsSpawn
+func (x *SkillSpecificFlags) GetGroundEffect() bool {
+	if x != nil {
+		return x.GroundEffect
 	}
 	return false
 }
 
-type Level struct {
+type SkillEffect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Level                int32                `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
-	Width                int32                `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
-	Height               int32                `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Horror               float32              `protobuf:"fixed32,4,opt,name=horror,proto3" json:"horror,omitempty"`
-	DeprecationInSeconds int32                `protobuf:"varint,5,opt,name=deprecation_in_seconds,json=deprecationInSeconds,proto3" json:"deprecation_in_seconds,omitempty"`
-	Objects              []*MapObjects        `protobuf:"bytes,6,rep,name=objects,proto3" json:"objects,omitempty"`
-	FogOfWar             []*FogOfWarMap       `protobuf:"bytes,7,rep,name=fog_of_war,json=fogOfWar,proto3" json:"fog_of_war,omitempty"`
-	PlayerMap            []*PlayerSpecificMap `protobuf:"bytes,8,rep,name=player_map,json=playerMap,proto3" json:"player_map,omitempty"`
+	Attributes *SkillAttributes    `protobuf:"bytes,1,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Flags      *SkillSpecificFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Summons    []*Droppable        `protobuf:"bytes,3,rep,name=summons,proto3" json:"summons,omitempty"`
 }
 
-func (x *Level) Reset() {
-	*x = Level{}
+func (x *SkillEffect) Reset() {
+	*x = SkillEffect{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[37]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Level) String() string {
+func (x *SkillEffect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Level) ProtoMessage() {}
+func (*SkillEffect) ProtoMessage() {}
 
-func (x *Level) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[37]
+func (x *SkillEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3080,94 +3507,57 @@ func (x *Level) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Level.ProtoReflect.Descriptor instead.
-func (*Level) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *Level) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
-}
-
-func (x *Level) GetWidth() int32 {
-	if x != nil {
-		return x.Width
-	}
-	return 0
-}
-
-func (x *Level) GetHeight() int32 {
-	if x != nil {
-		return x.Height
-	}
-	return 0
-}
-
-func (x *Level) GetHorror() float32 {
-	if x != nil {
-		return x.Horror
-	}
-	return 0
-}
-
-func (x *Level) GetDeprecationInSeconds() int32 {
-	if x != nil {
-		return x.DeprecationInSeconds
-	}
-	return 0
+// Deprecated: Use SkillEffect.ProtoReflect.Descriptor instead.
+func (*SkillEffect) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Level) GetObjects() []*MapObjects {
+func (x *SkillEffect) GetAttributes() *SkillAttributes {
 	if x != nil {
-		return x.Objects
+		return x.Attributes
 	}
 	return nil
 }
 
-func (x *Level) GetFogOfWar() []*FogOfWarMap {
+func (x *SkillEffect) GetFlags() *SkillSpecificFlags {
 	if x != nil {
-		return x.FogOfWar
+		return x.Flags
 	}
 	return nil
 }
 
-func (x *Level) GetPlayerMap() []*PlayerSpecificMap {
+func (x *SkillEffect) GetSummons() []*Droppable {
 	if x != nil {
-		return x.PlayerMap
+		return x.Summons
 	}
 	return nil
 }
 
-type PlayerSpecificMap struct {
+type Shortcut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position    *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	Distance    int32     `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
-	LineOfSight bool      `protobuf:"varint,3,opt,name=line_of_sight,json=lineOfSight,proto3" json:"line_of_sight,omitempty"`
+	LeadsTo *Coordinates `protobuf:"bytes,1,opt,name=leads_to,json=leadsTo,proto3,oneof" json:"leads_to,omitempty"`
 }
 
-func (x *PlayerSpecificMap) Reset() {
-	*x = PlayerSpecificMap{}
+func (x *Shortcut) Reset() {
+	*x = Shortcut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[38]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlayerSpecificMap) String() string {
+func (x *Shortcut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerSpecificMap) ProtoMessage() {}
+func (*Shortcut) ProtoMessage() {}
 
-func (x *PlayerSpecificMap) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[38]
+func (x *Shortcut) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3178,58 +3568,54 @@ func (x *PlayerSpecificMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerSpecificMap.ProtoReflect.Descriptor instead.
-func (*PlayerSpecificMap) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Shortcut.ProtoReflect.Descriptor instead.
+func (*Shortcut) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *PlayerSpecificMap) GetPosition() *Position {
+func (x *Shortcut) GetLeadsTo() *Coordinates {
 	if x != nil {
-		return x.Position
+		return x.LeadsTo
 	}
 	return nil
 }
 
-func (x *PlayerSpecificMap) GetDistance() int32 {
-	if x != nil {
-		return x.Distance
-	}
-	return 0
-}
-
-func (x *PlayerSpecificMap) GetLineOfSight() bool {
-	if x != nil {
-		return x.LineOfSight
-	}
-	return false
-}
-
-type FogOfWarMap struct {
+type MapObjects struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	FogOfWar bool      `protobuf:"varint,3,opt,name=fog_of_war,json=fogOfWar,proto3" json:"fog_of_war,omitempty"`
+	Position    *Position     `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Monsters    []*Monster    `protobuf:"bytes,2,rep,name=monsters,proto3" json:"monsters,omitempty"`
+	Players     []*Character  `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	IsStairs    bool          `protobuf:"varint,4,opt,name=is_stairs,json=isStairs,proto3" json:"is_stairs,omitempty"`
+	Portal      *Waypoint     `protobuf:"bytes,5,opt,name=portal,proto3,oneof" json:"portal,omitempty"`
+	Decorations []*Decoration `protobuf:"bytes,6,rep,name=decorations,proto3" json:"decorations,omitempty"`
+	Effects     []*Effect     `protobuf:"bytes,7,rep,name=effects,proto3" json:"effects,omitempty"`
+	Items       []*Item       `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	IsFree      bool          `protobuf:"varint,9,opt,name=is_free,json=isFree,proto3" json:"is_free,omitempty"`
+	IsWall      bool          `protobuf:"varint,10,opt,name=is_wall,json=isWall,proto3" json:"is_wall,omitempty"`
+	IsDoor      bool          `protobuf:"varint,11,opt,name=is_door,json=isDoor,proto3" json:"is_door,omitempty"`
+	IsSpawn     *bool         `protobuf:"varint,12,opt,name=is_spawn,json=isSpawn,proto3,oneof" json:"is_spawn,omitempty"`
 }
 
-func (x *FogOfWarMap) Reset() {
-	*x = FogOfWarMap{}
+func (x *MapObjects) Reset() {
+	*x = MapObjects{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[39]
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FogOfWarMap) String() string {
+func (x *MapObjects) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FogOfWarMap) ProtoMessage() {}
+func (*MapObjects) ProtoMessage() {}
 
-func (x *FogOfWarMap) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[39]
+func (x *MapObjects) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3240,91 +3626,112 @@ func (x *FogOfWarMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FogOfWarMap.ProtoReflect.Descriptor instead.
-func (*FogOfWarMap) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use MapObjects.ProtoReflect.Descriptor instead.
+func (*MapObjects) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *FogOfWarMap) GetPosition() *Position {
+func (x *MapObjects) GetPosition() *Position {
 	if x != nil {
 		return x.Position
 	}
 	return nil
 }
 
-func (x *FogOfWarMap) GetFogOfWar() bool {
+func (x *MapObjects) GetMonsters() []*Monster {
 	if x != nil {
-		return x.FogOfWar
+		return x.Monsters
 	}
-	return false
+	return nil
 }
 
-type Map struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MapObjects) GetPlayers() []*Character {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
 
-	Levels []*Level `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+func (x *MapObjects) GetIsStairs() bool {
+	if x != nil {
+		return x.IsStairs
+	}
+	return false
 }
 
-func (x *Map) Reset() {
-	*x = Map{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MapObjects) GetPortal() *Waypoint {
+	if x != nil {
+		return x.Portal
 	}
+	return nil
 }
 
-func (x *Map) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MapObjects) GetDecorations() []*Decoration {
+	if x != nil {
+		return x.Decorations
+	}
+	return nil
 }
 
-func (*Map) ProtoMessage() {}
+func (x *MapObjects) GetEffects() []*Effect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
 
-func (x *Map) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MapObjects) GetItems() []*Item {
+	if x != nil {
+		return x.Items
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Map.ProtoReflect.Descriptor instead.
-func (*Map) Descriptor() ([]byte, []int) {
-	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{40}
+func (x *MapObjects) GetIsFree() bool {
+	if x != nil {
+		return x.IsFree
+	}
+	return false
 }
 
-func (x *Map) GetLevels() []*Level {
+func (x *MapObjects) GetIsWall() bool {
 	if x != nil {
-		return x.Levels
+		return x.IsWall
 	}
-	return nil
+	return false
 }
 
-type Event struct {
+func (x *MapObjects) GetIsDoor() bool {
+	if x != nil {
+		return x.IsDoor
+	}
+	return false
+}
+
+func (x *MapObjects) GetIsSpawn() bool {
+	if x != nil && x.IsSpawn != nil {
+		return *x.IsSpawn
+	}
+	return false
+}
+
+type Level struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message     string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Type        *Event_Type  `protobuf:"varint,2,opt,name=type,proto3,enum=dungeonsandtrolls.Event_Type,oneof" json:"type,omitempty"`
-	Coordinates *Coordinates `protobuf:"bytes,3,opt,name=coordinates,proto3,oneof" json:"coordinates,omitempty"`
-	Damage      *float32     `protobuf:"fixed32,4,opt,name=damage,proto3,oneof" json:"damage,omitempty"`
-	PlayerId    *string      `protobuf:"bytes,5,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	SkillName   *string      `protobuf:"bytes,6,opt,name=skill_name,json=skillName,proto3,oneof" json:"skill_name,omitempty"`
-	Radius      *float32     `protobuf:"fixed32,7,opt,name=radius,proto3,oneof" json:"radius,omitempty"`
-	Target      *Coordinates `protobuf:"bytes,8,opt,name=target,proto3,oneof" json:"target,omitempty"`
-	Skill       *Skill       `protobuf:"bytes,9,opt,name=skill,proto3,oneof" json:"skill,omitempty"`
-	IsRanged    *bool        `protobuf:"varint,10,opt,name=is_ranged,json=isRanged,proto3,oneof" json:"is_ranged,omitempty"`
+	Level                int32                `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Width                int32                `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height               int32                `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Horror               float32              `protobuf:"fixed32,4,opt,name=horror,proto3" json:"horror,omitempty"`
+	DeprecationInSeconds int32                `protobuf:"varint,5,opt,name=deprecation_in_seconds,json=deprecationInSeconds,proto3" json:"deprecation_in_seconds,omitempty"`
+	Objects              []*MapObjects        `protobuf:"bytes,6,rep,name=objects,proto3" json:"objects,omitempty"`
+	FogOfWar             []*FogOfWarMap       `protobuf:"bytes,7,rep,name=fog_of_war,json=fogOfWar,proto3" json:"fog_of_war,omitempty"`
+	PlayerMap            []*PlayerSpecificMap `protobuf:"bytes,8,rep,name=player_map,json=playerMap,proto3" json:"player_map,omitempty"`
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
+func (x *Level) Reset() {
+	*x = Level{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3332,13 +3739,13 @@ func (x *Event) Reset() {
 	}
 }
 
-func (x *Event) String() string {
+func (x *Level) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*Level) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
+func (x *Level) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3350,99 +3757,79 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
+// Deprecated: Use Level.ProtoReflect.Descriptor instead.
+func (*Level) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Event) GetMessage() string {
+func (x *Level) GetLevel() int32 {
 	if x != nil {
-		return x.Message
+		return x.Level
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetType() Event_Type {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return Event_DAMAGE
-}
-
-func (x *Event) GetCoordinates() *Coordinates {
+func (x *Level) GetWidth() int32 {
 	if x != nil {
-		return x.Coordinates
-	}
-	return nil
-}
-
-func (x *Event) GetDamage() float32 {
-	if x != nil && x.Damage != nil {
-		return *x.Damage
+		return x.Width
 	}
 	return 0
 }
 
-func (x *Event) GetPlayerId() string {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *Level) GetHeight() int32 {
+	if x != nil {
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetSkillName() string {
-	if x != nil && x.SkillName != nil {
-		return *x.SkillName
+func (x *Level) GetHorror() float32 {
+	if x != nil {
+		return x.Horror
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetRadius() float32 {
-	if x != nil && x.Radius != nil {
-		return *x.Radius
+func (x *Level) GetDeprecationInSeconds() int32 {
+	if x != nil {
+		return x.DeprecationInSeconds
 	}
 	return 0
 }
 
-func (x *Event) GetTarget() *Coordinates {
+func (x *Level) GetObjects() []*MapObjects {
 	if x != nil {
-		return x.Target
+		return x.Objects
 	}
 	return nil
 }
 
-func (x *Event) GetSkill() *Skill {
+func (x *Level) GetFogOfWar() []*FogOfWarMap {
 	if x != nil {
-		return x.Skill
+		return x.FogOfWar
 	}
 	return nil
 }
 
-func (x *Event) GetIsRanged() bool {
-	if x != nil && x.IsRanged != nil {
-		return *x.IsRanged
+func (x *Level) GetPlayerMap() []*PlayerSpecificMap {
+	if x != nil {
+		return x.PlayerMap
 	}
-	return false
+	return nil
 }
 
-type GameState struct {
+type PlayerSpecificMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Map             *Map       `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
-	ShopItems       []*Item    `protobuf:"bytes,2,rep,name=shop_items,json=shopItems,proto3" json:"shop_items,omitempty"`
-	Character       *Character `protobuf:"bytes,3,opt,name=character,proto3,oneof" json:"character,omitempty"`
-	CurrentPosition *Position  `protobuf:"bytes,4,opt,name=current_position,json=currentPosition,proto3,oneof" json:"current_position,omitempty"`
-	CurrentLevel    *int32     `protobuf:"varint,5,opt,name=current_level,json=currentLevel,proto3,oneof" json:"current_level,omitempty"`
-	Tick            int32      `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"`
-	Events          []*Event   `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
-	Score           float32    `protobuf:"fixed32,8,opt,name=score,proto3" json:"score,omitempty"`
-	MaxLevel        int32      `protobuf:"varint,9,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Position    *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Distance    int32     `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	LineOfSight bool      `protobuf:"varint,3,opt,name=line_of_sight,json=lineOfSight,proto3" json:"line_of_sight,omitempty"`
 }
 
-func (x *GameState) Reset() {
-	*x = GameState{}
+func (x *PlayerSpecificMap) Reset() {
+	*x = PlayerSpecificMap{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3450,13 +3837,13 @@ func (x *GameState) Reset() {
 	}
 }
 
-func (x *GameState) String() string {
+func (x *PlayerSpecificMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState) ProtoMessage() {}
+func (*PlayerSpecificMap) ProtoMessage() {}
 
-func (x *GameState) ProtoReflect() protoreflect.Message {
+func (x *PlayerSpecificMap) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3468,84 +3855,43 @@ func (x *GameState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerSpecificMap.ProtoReflect.Descriptor instead.
+func (*PlayerSpecificMap) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GameState) GetMap() *Map {
-	if x != nil {
-		return x.Map
-	}
-	return nil
-}
-
-func (x *GameState) GetShopItems() []*Item {
-	if x != nil {
-		return x.ShopItems
-	}
-	return nil
-}
-
-func (x *GameState) GetCharacter() *Character {
-	if x != nil {
-		return x.Character
-	}
-	return nil
-}
-
-func (x *GameState) GetCurrentPosition() *Position {
-	if x != nil {
-		return x.CurrentPosition
-	}
-	return nil
-}
-
-func (x *GameState) GetCurrentLevel() int32 {
-	if x != nil && x.CurrentLevel != nil {
-		return *x.CurrentLevel
-	}
-	return 0
-}
-
-func (x *GameState) GetTick() int32 {
-	if x != nil {
-		return x.Tick
-	}
-	return 0
-}
-
-func (x *GameState) GetEvents() []*Event {
+func (x *PlayerSpecificMap) GetPosition() *Position {
 	if x != nil {
-		return x.Events
+		return x.Position
 	}
 	return nil
 }
 
-func (x *GameState) GetScore() float32 {
+func (x *PlayerSpecificMap) GetDistance() int32 {
 	if x != nil {
-		return x.Score
+		return x.Distance
 	}
 	return 0
 }
 
-func (x *GameState) GetMaxLevel() int32 {
+func (x *PlayerSpecificMap) GetLineOfSight() bool {
 	if x != nil {
-		return x.MaxLevel
+		return x.LineOfSight
 	}
-	return 0
+	return false
 }
 
-type User struct {
+type FogOfWarMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	FogOfWar bool      `protobuf:"varint,3,opt,name=fog_of_war,json=fogOfWar,proto3" json:"fog_of_war,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *FogOfWarMap) Reset() {
+	*x = FogOfWarMap{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3553,13 +3899,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *FogOfWarMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*FogOfWarMap) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *FogOfWarMap) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3571,28 +3917,35 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use FogOfWarMap.ProtoReflect.Descriptor instead.
+func (*FogOfWarMap) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *User) GetUsername() string {
+func (x *FogOfWarMap) GetPosition() *Position {
 	if x != nil {
-		return x.Username
+		return x.Position
 	}
-	return ""
+	return nil
 }
 
-type Identifier struct {
+func (x *FogOfWarMap) GetFogOfWar() bool {
+	if x != nil {
+		return x.FogOfWar
+	}
+	return false
+}
+
+type Map struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Levels []*Level `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
 }
 
-func (x *Identifier) Reset() {
-	*x = Identifier{}
+func (x *Map) Reset() {
+	*x = Map{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3600,13 +3953,13 @@ func (x *Identifier) Reset() {
 	}
 }
 
-func (x *Identifier) String() string {
+func (x *Map) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Identifier) ProtoMessage() {}
+func (*Map) ProtoMessage() {}
 
-func (x *Identifier) ProtoReflect() protoreflect.Message {
+func (x *Map) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3618,28 +3971,42 @@ func (x *Identifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Identifier.ProtoReflect.Descriptor instead.
-func (*Identifier) Descriptor() ([]byte, []int) {
+// Deprecated: Use Map.ProtoReflect.Descriptor instead.
+func (*Map) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Identifier) GetId() string {
+func (x *Map) GetLevels() []*Level {
 	if x != nil {
-		return x.Id
+		return x.Levels
 	}
-	return ""
+	return nil
 }
 
-type Identifiers struct {
+type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Message     string        `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type        *Event_Type   `protobuf:"varint,2,opt,name=type,proto3,enum=dungeonsandtrolls.Event_Type,oneof" json:"type,omitempty"`
+	Coordinates *Coordinates  `protobuf:"bytes,3,opt,name=coordinates,proto3,oneof" json:"coordinates,omitempty"`
+	Damage      *float32      `protobuf:"fixed32,4,opt,name=damage,proto3,oneof" json:"damage,omitempty"`
+	PlayerId    *string       `protobuf:"bytes,5,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	SkillName   *string       `protobuf:"bytes,6,opt,name=skill_name,json=skillName,proto3,oneof" json:"skill_name,omitempty"`
+	Radius      *float32      `protobuf:"fixed32,7,opt,name=radius,proto3,oneof" json:"radius,omitempty"`
+	Target      *Coordinates  `protobuf:"bytes,8,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	Skill       *Skill        `protobuf:"bytes,9,opt,name=skill,proto3,oneof" json:"skill,omitempty"`
+	IsRanged    *bool         `protobuf:"varint,10,opt,name=is_ranged,json=isRanged,proto3,oneof" json:"is_ranged,omitempty"`
+	KillerId    *string       `protobuf:"bytes,11,opt,name=killer_id,json=killerId,proto3,oneof" json:"killer_id,omitempty"`
+	Score       *float32      `protobuf:"fixed32,12,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Credits     []*KillCredit `protobuf:"bytes,13,rep,name=credits,proto3" json:"credits,omitempty"`
+	CasterId    *string       `protobuf:"bytes,14,opt,name=caster_id,json=casterId,proto3,oneof" json:"caster_id,omitempty"`
+	VictimId    *string       `protobuf:"bytes,15,opt,name=victim_id,json=victimId,proto3,oneof" json:"victim_id,omitempty"`
 }
 
-func (x *Identifiers) Reset() {
-	*x = Identifiers{}
+func (x *Event) Reset() {
+	*x = Event{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3647,13 +4014,13 @@ func (x *Identifiers) Reset() {
 	}
 }
 
-func (x *Identifiers) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Identifiers) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *Identifiers) ProtoReflect() protoreflect.Message {
+func (x *Event) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3665,93 +4032,207 @@ func (x *Identifiers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Identifiers.ProtoReflect.Descriptor instead.
-func (*Identifiers) Descriptor() ([]byte, []int) {
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Identifiers) GetIds() []string {
+func (x *Event) GetMessage() string {
 	if x != nil {
-		return x.Ids
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type Coordinates struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Level     int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
-	PositionX int32 `protobuf:"varint,2,opt,name=position_x,json=positionX,proto3" json:"position_x,omitempty"`
-	PositionY int32 `protobuf:"varint,3,opt,name=position_y,json=positionY,proto3" json:"position_y,omitempty"`
+func (x *Event) GetType() Event_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Event_DAMAGE
 }
 
-func (x *Coordinates) Reset() {
-	*x = Coordinates{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[46]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Event) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
 	}
+	return nil
 }
 
-func (x *Coordinates) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Event) GetDamage() float32 {
+	if x != nil && x.Damage != nil {
+		return *x.Damage
+	}
+	return 0
 }
 
-func (*Coordinates) ProtoMessage() {}
-
-func (x *Coordinates) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[46]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Event) GetPlayerId() string {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
-func (*Coordinates) Descriptor() ([]byte, []int) {
+func (x *Event) GetSkillName() string {
+	if x != nil && x.SkillName != nil {
+		return *x.SkillName
+	}
+	return ""
+}
+
+func (x *Event) GetRadius() float32 {
+	if x != nil && x.Radius != nil {
+		return *x.Radius
+	}
+	return 0
+}
+
+func (x *Event) GetTarget() *Coordinates {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Event) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+func (x *Event) GetIsRanged() bool {
+	if x != nil && x.IsRanged != nil {
+		return *x.IsRanged
+	}
+	return false
+}
+
+func (x *Event) GetKillerId() string {
+	if x != nil && x.KillerId != nil {
+		return *x.KillerId
+	}
+	return ""
+}
+
+func (x *Event) GetScore() float32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *Event) GetCredits() []*KillCredit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *Event) GetCasterId() string {
+	if x != nil && x.CasterId != nil {
+		return *x.CasterId
+	}
+	return ""
+}
+
+func (x *Event) GetVictimId() string {
+	if x != nil && x.VictimId != nil {
+		return *x.VictimId
+	}
+	return ""
+}
+
+type KillCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Damage      float32  `protobuf:"fixed32,2,opt,name=damage,proto3" json:"damage,omitempty"`
+	Share       float32  `protobuf:"fixed32,3,opt,name=share,proto3" json:"share,omitempty"`
+	KillingBlow bool     `protobuf:"varint,4,opt,name=killing_blow,json=killingBlow,proto3" json:"killing_blow,omitempty"`
+	Score       *float32 `protobuf:"fixed32,5,opt,name=score,proto3,oneof" json:"score,omitempty"`
+}
+
+func (x *KillCredit) Reset() {
+	*x = KillCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillCredit) ProtoMessage() {}
+
+func (x *KillCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillCredit.ProtoReflect.Descriptor instead.
+func (*KillCredit) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Coordinates) GetLevel() int32 {
+func (x *KillCredit) GetId() string {
 	if x != nil {
-		return x.Level
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KillCredit) GetDamage() float32 {
+	if x != nil {
+		return x.Damage
 	}
 	return 0
 }
 
-func (x *Coordinates) GetPositionX() int32 {
+func (x *KillCredit) GetShare() float32 {
 	if x != nil {
-		return x.PositionX
+		return x.Share
 	}
 	return 0
 }
 
-func (x *Coordinates) GetPositionY() int32 {
+func (x *KillCredit) GetKillingBlow() bool {
 	if x != nil {
-		return x.PositionY
+		return x.KillingBlow
+	}
+	return false
+}
+
+func (x *KillCredit) GetScore() float32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
 	}
 	return 0
 }
 
-type SkillUse struct {
+type DamageRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SkillId  string    `protobuf:"bytes,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
-	TargetId *string   `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
-	Position *Position `protobuf:"bytes,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	CasterId string  `protobuf:"bytes,1,opt,name=caster_id,json=casterId,proto3" json:"caster_id,omitempty"`
+	Damage   float32 `protobuf:"fixed32,2,opt,name=damage,proto3" json:"damage,omitempty"`
+	Tick     int32   `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty"`
 }
 
-func (x *SkillUse) Reset() {
-	*x = SkillUse{}
+func (x *DamageRecord) Reset() {
+	*x = DamageRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3759,13 +4240,13 @@ func (x *SkillUse) Reset() {
 	}
 }
 
-func (x *SkillUse) String() string {
+func (x *DamageRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillUse) ProtoMessage() {}
+func (*DamageRecord) ProtoMessage() {}
 
-func (x *SkillUse) ProtoReflect() protoreflect.Message {
+func (x *DamageRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3777,42 +4258,52 @@ func (x *SkillUse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillUse.ProtoReflect.Descriptor instead.
-func (*SkillUse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DamageRecord.ProtoReflect.Descriptor instead.
+func (*DamageRecord) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SkillUse) GetSkillId() string {
+func (x *DamageRecord) GetCasterId() string {
 	if x != nil {
-		return x.SkillId
+		return x.CasterId
 	}
 	return ""
 }
 
-func (x *SkillUse) GetTargetId() string {
-	if x != nil && x.TargetId != nil {
-		return *x.TargetId
+func (x *DamageRecord) GetDamage() float32 {
+	if x != nil {
+		return x.Damage
 	}
-	return ""
+	return 0
 }
 
-func (x *SkillUse) GetPosition() *Position {
+func (x *DamageRecord) GetTick() int32 {
 	if x != nil {
-		return x.Position
+		return x.Tick
 	}
-	return nil
+	return 0
 }
 
-type Registration struct {
+type GameState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiKey *string `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3,oneof" json:"api_key,omitempty"`
+	Map             *Map            `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
+	ShopItems       []*Item         `protobuf:"bytes,2,rep,name=shop_items,json=shopItems,proto3" json:"shop_items,omitempty"`
+	Character       *Character      `protobuf:"bytes,3,opt,name=character,proto3,oneof" json:"character,omitempty"`
+	CurrentPosition *Position       `protobuf:"bytes,4,opt,name=current_position,json=currentPosition,proto3,oneof" json:"current_position,omitempty"`
+	CurrentLevel    *int32          `protobuf:"varint,5,opt,name=current_level,json=currentLevel,proto3,oneof" json:"current_level,omitempty"`
+	Tick            int32           `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"`
+	Events          []*Event        `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	Score           float32         `protobuf:"fixed32,8,opt,name=score,proto3" json:"score,omitempty"`
+	MaxLevel        int32           `protobuf:"varint,9,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Delta           *GameStateDelta `protobuf:"bytes,10,opt,name=delta,proto3,oneof" json:"delta,omitempty"`
+	CommandResult   *CommandResult  `protobuf:"bytes,11,opt,name=command_result,json=commandResult,proto3,oneof" json:"command_result,omitempty"`
 }
 
-func (x *Registration) Reset() {
-	*x = Registration{}
+func (x *GameState) Reset() {
+	*x = GameState{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3820,13 +4311,13 @@ func (x *Registration) Reset() {
 	}
 }
 
-func (x *Registration) String() string {
+func (x *GameState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Registration) ProtoMessage() {}
+func (*GameState) ProtoMessage() {}
 
-func (x *Registration) ProtoReflect() protoreflect.Message {
+func (x *GameState) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3838,115 +4329,2619 @@ func (x *Registration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Registration.ProtoReflect.Descriptor instead.
-func (*Registration) Descriptor() ([]byte, []int) {
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
 	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Registration) GetApiKey() string {
-	if x != nil && x.ApiKey != nil {
-		return *x.ApiKey
+func (x *GameState) GetMap() *Map {
+	if x != nil {
+		return x.Map
 	}
-	return ""
+	return nil
 }
 
-var File_proto_dungeonsandtrolls_proto protoreflect.FileDescriptor
+func (x *GameState) GetShopItems() []*Item {
+	if x != nil {
+		return x.ShopItems
+	}
+	return nil
+}
 
-var file_proto_dungeonsandtrolls_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
-	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x11, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x01,
-	0x0a, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e,
-	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x40, 0x0a,
-	0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x73, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12,
-	0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x7b, 0x0a,
-	0x12, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
-	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x08,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x73, 0x0a, 0x11, 0x52, 0x65,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x30, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88,
-	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22,
-	0x7c, 0x0a, 0x12, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x75,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
-	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x6b, 0x69,
-	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x77, 0x0a,
-	0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e,
-	0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e,
-	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0d, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x08, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5a, 0x0a, 0x15, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x75, 0x6e,
-	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x4d,
-	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3d,
-	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x3d, 0x0a, 0x0d, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x08,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x1d, 0x0a, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x63,
-	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
-	0x63, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+func (x *GameState) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *GameState) GetCurrentPosition() *Position {
+	if x != nil {
+		return x.CurrentPosition
+	}
+	return nil
+}
+
+func (x *GameState) GetCurrentLevel() int32 {
+	if x != nil && x.CurrentLevel != nil {
+		return *x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *GameState) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GameState) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GameState) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GameState) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *GameState) GetDelta() *GameStateDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *GameState) GetCommandResult() *CommandResult {
+	if x != nil {
+		return x.CommandResult
+	}
+	return nil
+}
+
+type GameStateDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SinceTick        int32          `protobuf:"varint,1,opt,name=since_tick,json=sinceTick,proto3" json:"since_tick,omitempty"`
+	RemovedPositions []*Coordinates `protobuf:"bytes,2,rep,name=removed_positions,json=removedPositions,proto3" json:"removed_positions,omitempty"`
+	RemovedIds       []string       `protobuf:"bytes,3,rep,name=removed_ids,json=removedIds,proto3" json:"removed_ids,omitempty"`
+	RemovedLevels    []int32        `protobuf:"varint,4,rep,packed,name=removed_levels,json=removedLevels,proto3" json:"removed_levels,omitempty"`
+	FullLevels       []int32        `protobuf:"varint,5,rep,packed,name=full_levels,json=fullLevels,proto3" json:"full_levels,omitempty"`
+}
+
+func (x *GameStateDelta) Reset() {
+	*x = GameStateDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameStateDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStateDelta) ProtoMessage() {}
+
+func (x *GameStateDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStateDelta.ProtoReflect.Descriptor instead.
+func (*GameStateDelta) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GameStateDelta) GetSinceTick() int32 {
+	if x != nil {
+		return x.SinceTick
+	}
+	return 0
+}
+
+func (x *GameStateDelta) GetRemovedPositions() []*Coordinates {
+	if x != nil {
+		return x.RemovedPositions
+	}
+	return nil
+}
+
+func (x *GameStateDelta) GetRemovedIds() []string {
+	if x != nil {
+		return x.RemovedIds
+	}
+	return nil
+}
+
+func (x *GameStateDelta) GetRemovedLevels() []int32 {
+	if x != nil {
+		return x.RemovedLevels
+	}
+	return nil
+}
+
+func (x *GameStateDelta) GetFullLevels() []int32 {
+	if x != nil {
+		return x.FullLevels
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type Identifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Identifier) Reset() {
+	*x = Identifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Identifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identifier) ProtoMessage() {}
+
+func (x *Identifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identifier.ProtoReflect.Descriptor instead.
+func (*Identifier) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Identifier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Identifiers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *Identifiers) Reset() {
+	*x = Identifiers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Identifiers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identifiers) ProtoMessage() {}
+
+func (x *Identifiers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identifiers.ProtoReflect.Descriptor instead.
+func (*Identifiers) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Identifiers) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type Coordinates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level     int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	PositionX int32 `protobuf:"varint,2,opt,name=position_x,json=positionX,proto3" json:"position_x,omitempty"`
+	PositionY int32 `protobuf:"varint,3,opt,name=position_y,json=positionY,proto3" json:"position_y,omitempty"`
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Coordinates) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Coordinates) GetPositionX() int32 {
+	if x != nil {
+		return x.PositionX
+	}
+	return 0
+}
+
+func (x *Coordinates) GetPositionY() int32 {
+	if x != nil {
+		return x.PositionY
+	}
+	return 0
+}
+
+type SkillUse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SkillId  string    `protobuf:"bytes,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	TargetId *string   `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	Position *Position `protobuf:"bytes,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+}
+
+func (x *SkillUse) Reset() {
+	*x = SkillUse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkillUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillUse) ProtoMessage() {}
+
+func (x *SkillUse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillUse.ProtoReflect.Descriptor instead.
+func (*SkillUse) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SkillUse) GetSkillId() string {
+	if x != nil {
+		return x.SkillId
+	}
+	return ""
+}
+
+func (x *SkillUse) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *SkillUse) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type Registration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *string `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3,oneof" json:"api_key,omitempty"`
+}
+
+func (x *Registration) Reset() {
+	*x = Registration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Registration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registration) ProtoMessage() {}
+
+func (x *Registration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registration.ProtoReflect.Descriptor instead.
+func (*Registration) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Registration) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return ""
+}
+
+type ApiKeyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Roles   []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Label   *string  `protobuf:"bytes,3,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Created int64    `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Current bool     `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	Owner   *string  `protobuf:"bytes,6,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+}
+
+func (x *ApiKeyInfo) Reset() {
+	*x = ApiKeyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyInfo) ProtoMessage() {}
+
+func (x *ApiKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyInfo.ProtoReflect.Descriptor instead.
+func (*ApiKeyInfo) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ApiKeyInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ApiKeyInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *ApiKeyInfo) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+type ApiKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*ApiKeyInfo `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ApiKeys) Reset() {
+	*x = ApiKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeys) ProtoMessage() {}
+
+func (x *ApiKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeys.ProtoReflect.Descriptor instead.
+func (*ApiKeys) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ApiKeys) GetKeys() []*ApiKeyInfo {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ApiKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner *string `protobuf:"bytes,1,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+}
+
+func (x *ApiKeysRequest) Reset() {
+	*x = ApiKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeysRequest) ProtoMessage() {}
+
+func (x *ApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ApiKeysRequest) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+type ApiKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Label *string  `protobuf:"bytes,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+}
+
+func (x *ApiKeyRequest) Reset() {
+	*x = ApiKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyRequest) ProtoMessage() {}
+
+func (x *ApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*ApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ApiKeyRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ApiKeyRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+type KickPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ban bool   `protobuf:"varint,2,opt,name=ban,proto3" json:"ban,omitempty"`
+}
+
+func (x *KickPlayerRequest) Reset() {
+	*x = KickPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerRequest) ProtoMessage() {}
+
+func (x *KickPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerRequest.ProtoReflect.Descriptor instead.
+func (*KickPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *KickPlayerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetBan() bool {
+	if x != nil {
+		return x.Ban
+	}
+	return false
+}
+
+type TeleportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Coordinates *Coordinates `protobuf:"bytes,2,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+}
+
+func (x *TeleportRequest) Reset() {
+	*x = TeleportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeleportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeleportRequest) ProtoMessage() {}
+
+func (x *TeleportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeleportRequest.ProtoReflect.Descriptor instead.
+func (*TeleportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TeleportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TeleportRequest) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type LevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *LevelRequest) Reset() {
+	*x = LevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelRequest) ProtoMessage() {}
+
+func (x *LevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelRequest.ProtoReflect.Descriptor instead.
+func (*LevelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LevelRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type GrantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Money       *int32   `protobuf:"varint,2,opt,name=money,proto3,oneof" json:"money,omitempty"`
+	SkillPoints *float32 `protobuf:"fixed32,3,opt,name=skill_points,json=skillPoints,proto3,oneof" json:"skill_points,omitempty"`
+	Item        *Item    `protobuf:"bytes,4,opt,name=item,proto3,oneof" json:"item,omitempty"`
+}
+
+func (x *GrantRequest) Reset() {
+	*x = GrantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRequest) ProtoMessage() {}
+
+func (x *GrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRequest.ProtoReflect.Descriptor instead.
+func (*GrantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GrantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GrantRequest) GetMoney() int32 {
+	if x != nil && x.Money != nil {
+		return *x.Money
+	}
+	return 0
+}
+
+func (x *GrantRequest) GetSkillPoints() float32 {
+	if x != nil && x.SkillPoints != nil {
+		return *x.SkillPoints
+	}
+	return 0
+}
+
+func (x *GrantRequest) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type GameProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score           *float32 `protobuf:"fixed32,1,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxLevelReached *int32   `protobuf:"varint,2,opt,name=max_level_reached,json=maxLevelReached,proto3,oneof" json:"max_level_reached,omitempty"`
+}
+
+func (x *GameProgress) Reset() {
+	*x = GameProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameProgress) ProtoMessage() {}
+
+func (x *GameProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameProgress.ProtoReflect.Descriptor instead.
+func (*GameProgress) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GameProgress) GetScore() float32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *GameProgress) GetMaxLevelReached() int32 {
+	if x != nil && x.MaxLevelReached != nil {
+		return *x.MaxLevelReached
+	}
+	return 0
+}
+
+type Entity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Entity:
+	//	*Entity_Character
+	//	*Entity_Monster
+	//	*Entity_Item
+	Entity      isEntity_Entity `protobuf_oneof:"entity"`
+	Coordinates *Coordinates    `protobuf:"bytes,4,opt,name=coordinates,proto3,oneof" json:"coordinates,omitempty"`
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{65}
+}
+
+func (m *Entity) GetEntity() isEntity_Entity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (x *Entity) GetCharacter() *Character {
+	if x, ok := x.GetEntity().(*Entity_Character); ok {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *Entity) GetMonster() *Monster {
+	if x, ok := x.GetEntity().(*Entity_Monster); ok {
+		return x.Monster
+	}
+	return nil
+}
+
+func (x *Entity) GetItem() *Item {
+	if x, ok := x.GetEntity().(*Entity_Item); ok {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Entity) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type isEntity_Entity interface {
+	isEntity_Entity()
+}
+
+type Entity_Character struct {
+	Character *Character `protobuf:"bytes,1,opt,name=character,proto3,oneof"`
+}
+
+type Entity_Monster struct {
+	Monster *Monster `protobuf:"bytes,2,opt,name=monster,proto3,oneof"`
+}
+
+type Entity_Item struct {
+	Item *Item `protobuf:"bytes,3,opt,name=item,proto3,oneof"`
+}
+
+func (*Entity_Character) isEntity_Entity() {}
+
+func (*Entity_Monster) isEntity_Entity() {}
+
+func (*Entity_Item) isEntity_Entity() {}
+
+type SpawnMonsterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coordinates *Coordinates `protobuf:"bytes,1,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Monster     *Monster     `protobuf:"bytes,2,opt,name=monster,proto3" json:"monster,omitempty"`
+}
+
+func (x *SpawnMonsterRequest) Reset() {
+	*x = SpawnMonsterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpawnMonsterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnMonsterRequest) ProtoMessage() {}
+
+func (x *SpawnMonsterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnMonsterRequest.ProtoReflect.Descriptor instead.
+func (*SpawnMonsterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SpawnMonsterRequest) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *SpawnMonsterRequest) GetMonster() *Monster {
+	if x != nil {
+		return x.Monster
+	}
+	return nil
+}
+
+type DropItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coordinates *Coordinates `protobuf:"bytes,1,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Item        *Item        `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *DropItemRequest) Reset() {
+	*x = DropItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropItemRequest) ProtoMessage() {}
+
+func (x *DropItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropItemRequest.ProtoReflect.Descriptor instead.
+func (*DropItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DropItemRequest) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *DropItemRequest) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SetTileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coordinates *Coordinates            `protobuf:"bytes,1,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Type        SetTileRequest_TileType `protobuf:"varint,2,opt,name=type,proto3,enum=dungeonsandtrolls.SetTileRequest_TileType" json:"type,omitempty"`
+}
+
+func (x *SetTileRequest) Reset() {
+	*x = SetTileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTileRequest) ProtoMessage() {}
+
+func (x *SetTileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTileRequest.ProtoReflect.Descriptor instead.
+func (*SetTileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetTileRequest) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *SetTileRequest) GetType() SetTileRequest_TileType {
+	if x != nil {
+		return x.Type
+	}
+	return SetTileRequest_FREE
+}
+
+type PlaceEffectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coordinates *Coordinates `protobuf:"bytes,1,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Effect      *Effect      `protobuf:"bytes,2,opt,name=effect,proto3" json:"effect,omitempty"`
+}
+
+func (x *PlaceEffectRequest) Reset() {
+	*x = PlaceEffectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceEffectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceEffectRequest) ProtoMessage() {}
+
+func (x *PlaceEffectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceEffectRequest.ProtoReflect.Descriptor instead.
+func (*PlaceEffectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PlaceEffectRequest) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *PlaceEffectRequest) GetEffect() *Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return nil
+}
+
+type ApiKeyUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId    string  `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Owner    *string `protobuf:"bytes,2,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Requests int64   `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	Rejected int64   `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Blocking int32   `protobuf:"varint,5,opt,name=blocking,proto3" json:"blocking,omitempty"`
+}
+
+func (x *ApiKeyUsage) Reset() {
+	*x = ApiKeyUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyUsage) ProtoMessage() {}
+
+func (x *ApiKeyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyUsage.ProtoReflect.Descriptor instead.
+func (*ApiKeyUsage) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ApiKeyUsage) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetBlocking() int32 {
+	if x != nil {
+		return x.Blocking
+	}
+	return 0
+}
+
+type ApiKeysUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage []*ApiKeyUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *ApiKeysUsage) Reset() {
+	*x = ApiKeysUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKeysUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeysUsage) ProtoMessage() {}
+
+func (x *ApiKeysUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeysUsage.ProtoReflect.Descriptor instead.
+func (*ApiKeysUsage) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ApiKeysUsage) GetUsage() []*ApiKeyUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type Balance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResistConstant     float64 `protobuf:"fixed64,1,opt,name=resist_constant,json=resistConstant,proto3" json:"resist_constant,omitempty"`
+	MinResist          float64 `protobuf:"fixed64,2,opt,name=min_resist,json=minResist,proto3" json:"min_resist,omitempty"`
+	BaseStat           float32 `protobuf:"fixed32,3,opt,name=base_stat,json=baseStat,proto3" json:"base_stat,omitempty"`
+	BaseResist         float32 `protobuf:"fixed32,4,opt,name=base_resist,json=baseResist,proto3" json:"base_resist,omitempty"`
+	MoneyBase          float64 `protobuf:"fixed64,5,opt,name=money_base,json=moneyBase,proto3" json:"money_base,omitempty"`
+	MoneyScoreFactor   float64 `protobuf:"fixed64,6,opt,name=money_score_factor,json=moneyScoreFactor,proto3" json:"money_score_factor,omitempty"`
+	MoneyScoreExponent float64 `protobuf:"fixed64,7,opt,name=money_score_exponent,json=moneyScoreExponent,proto3" json:"money_score_exponent,omitempty"`
+	OutOfCombatTicks   int32   `protobuf:"varint,8,opt,name=out_of_combat_ticks,json=outOfCombatTicks,proto3" json:"out_of_combat_ticks,omitempty"`
+	RangedDistance     float64 `protobuf:"fixed64,9,opt,name=ranged_distance,json=rangedDistance,proto3" json:"ranged_distance,omitempty"`
+	File               *string `protobuf:"bytes,10,opt,name=file,proto3,oneof" json:"file,omitempty"`
+	AppliedTick        int32   `protobuf:"varint,11,opt,name=applied_tick,json=appliedTick,proto3" json:"applied_tick,omitempty"`
+	ReloadError        *string `protobuf:"bytes,12,opt,name=reload_error,json=reloadError,proto3,oneof" json:"reload_error,omitempty"`
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Balance) GetResistConstant() float64 {
+	if x != nil {
+		return x.ResistConstant
+	}
+	return 0
+}
+
+func (x *Balance) GetMinResist() float64 {
+	if x != nil {
+		return x.MinResist
+	}
+	return 0
+}
+
+func (x *Balance) GetBaseStat() float32 {
+	if x != nil {
+		return x.BaseStat
+	}
+	return 0
+}
+
+func (x *Balance) GetBaseResist() float32 {
+	if x != nil {
+		return x.BaseResist
+	}
+	return 0
+}
+
+func (x *Balance) GetMoneyBase() float64 {
+	if x != nil {
+		return x.MoneyBase
+	}
+	return 0
+}
+
+func (x *Balance) GetMoneyScoreFactor() float64 {
+	if x != nil {
+		return x.MoneyScoreFactor
+	}
+	return 0
+}
+
+func (x *Balance) GetMoneyScoreExponent() float64 {
+	if x != nil {
+		return x.MoneyScoreExponent
+	}
+	return 0
+}
+
+func (x *Balance) GetOutOfCombatTicks() int32 {
+	if x != nil {
+		return x.OutOfCombatTicks
+	}
+	return 0
+}
+
+func (x *Balance) GetRangedDistance() float64 {
+	if x != nil {
+		return x.RangedDistance
+	}
+	return 0
+}
+
+func (x *Balance) GetFile() string {
+	if x != nil && x.File != nil {
+		return *x.File
+	}
+	return ""
+}
+
+func (x *Balance) GetAppliedTick() int32 {
+	if x != nil {
+		return x.AppliedTick
+	}
+	return 0
+}
+
+func (x *Balance) GetReloadError() string {
+	if x != nil && x.ReloadError != nil {
+		return *x.ReloadError
+	}
+	return ""
+}
+
+type StepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticks int32 `protobuf:"varint,1,opt,name=ticks,proto3" json:"ticks,omitempty"`
+}
+
+func (x *StepRequest) Reset() {
+	*x = StepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepRequest) ProtoMessage() {}
+
+func (x *StepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepRequest.ProtoReflect.Descriptor instead.
+func (*StepRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StepRequest) GetTicks() int32 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
+}
+
+type StepResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick int32 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *StepResult) Reset() {
+	*x = StepResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepResult) ProtoMessage() {}
+
+func (x *StepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepResult.ProtoReflect.Descriptor instead.
+func (*StepResult) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *StepResult) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+type WorldSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick      int32                     `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Levels    []*LevelSnapshot          `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
+	ShopItems []*Item                   `protobuf:"bytes,3,rep,name=shop_items,json=shopItems,proto3" json:"shop_items,omitempty"`
+	Monsters  []*MonsterSnapshot        `protobuf:"bytes,4,rep,name=monsters,proto3" json:"monsters,omitempty"`
+	Players   []*PlayerSnapshot         `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	Commands  map[string]*CommandsBatch `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Respawns  []string                  `protobuf:"bytes,7,rep,name=respawns,proto3" json:"respawns,omitempty"`
+}
+
+func (x *WorldSnapshot) Reset() {
+	*x = WorldSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorldSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSnapshot) ProtoMessage() {}
+
+func (x *WorldSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSnapshot.ProtoReflect.Descriptor instead.
+func (*WorldSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *WorldSnapshot) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *WorldSnapshot) GetLevels() []*LevelSnapshot {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *WorldSnapshot) GetShopItems() []*Item {
+	if x != nil {
+		return x.ShopItems
+	}
+	return nil
+}
+
+func (x *WorldSnapshot) GetMonsters() []*MonsterSnapshot {
+	if x != nil {
+		return x.Monsters
+	}
+	return nil
+}
+
+func (x *WorldSnapshot) GetPlayers() []*PlayerSnapshot {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *WorldSnapshot) GetCommands() map[string]*CommandsBatch {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *WorldSnapshot) GetRespawns() []string {
+	if x != nil {
+		return x.Respawns
+	}
+	return nil
+}
+
+type LevelSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level              *Level       `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	GeneratedTick      int32        `protobuf:"varint,2,opt,name=generated_tick,json=generatedTick,proto3" json:"generated_tick,omitempty"`
+	LastInteractedTick int32        `protobuf:"varint,3,opt,name=last_interacted_tick,json=lastInteractedTick,proto3" json:"last_interacted_tick,omitempty"`
+	SpawnPoint         *Coordinates `protobuf:"bytes,4,opt,name=spawn_point,json=spawnPoint,proto3" json:"spawn_point,omitempty"`
+}
+
+func (x *LevelSnapshot) Reset() {
+	*x = LevelSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LevelSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelSnapshot) ProtoMessage() {}
+
+func (x *LevelSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelSnapshot.ProtoReflect.Descriptor instead.
+func (*LevelSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *LevelSnapshot) GetLevel() *Level {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+func (x *LevelSnapshot) GetGeneratedTick() int32 {
+	if x != nil {
+		return x.GeneratedTick
+	}
+	return 0
+}
+
+func (x *LevelSnapshot) GetLastInteractedTick() int32 {
+	if x != nil {
+		return x.LastInteractedTick
+	}
+	return 0
+}
+
+func (x *LevelSnapshot) GetSpawnPoint() *Coordinates {
+	if x != nil {
+		return x.SpawnPoint
+	}
+	return nil
+}
+
+type MonsterSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position     *Coordinates    `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	KillCounter  *int32          `protobuf:"varint,3,opt,name=kill_counter,json=killCounter,proto3,oneof" json:"kill_counter,omitempty"`
+	MovingTo     *PathSnapshot   `protobuf:"bytes,4,opt,name=moving_to,json=movingTo,proto3" json:"moving_to,omitempty"`
+	SummonedBy   *string         `protobuf:"bytes,5,opt,name=summoned_by,json=summonedBy,proto3,oneof" json:"summoned_by,omitempty"`
+	DamageLedger []*DamageRecord `protobuf:"bytes,6,rep,name=damage_ledger,json=damageLedger,proto3" json:"damage_ledger,omitempty"`
+}
+
+func (x *MonsterSnapshot) Reset() {
+	*x = MonsterSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonsterSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonsterSnapshot) ProtoMessage() {}
+
+func (x *MonsterSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonsterSnapshot.ProtoReflect.Descriptor instead.
+func (*MonsterSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MonsterSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MonsterSnapshot) GetPosition() *Coordinates {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *MonsterSnapshot) GetKillCounter() int32 {
+	if x != nil && x.KillCounter != nil {
+		return *x.KillCounter
+	}
+	return 0
+}
+
+func (x *MonsterSnapshot) GetMovingTo() *PathSnapshot {
+	if x != nil {
+		return x.MovingTo
+	}
+	return nil
+}
+
+func (x *MonsterSnapshot) GetSummonedBy() string {
+	if x != nil && x.SummonedBy != nil {
+		return *x.SummonedBy
+	}
+	return ""
+}
+
+func (x *MonsterSnapshot) GetDamageLedger() []*DamageRecord {
+	if x != nil {
+		return x.DamageLedger
+	}
+	return nil
+}
+
+type PlayerSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Character      *Character      `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	BaseAttributes *Attributes     `protobuf:"bytes,2,opt,name=base_attributes,json=baseAttributes,proto3" json:"base_attributes,omitempty"`
+	MovingTo       *PathSnapshot   `protobuf:"bytes,3,opt,name=moving_to,json=movingTo,proto3" json:"moving_to,omitempty"`
+	DamageLedger   []*DamageRecord `protobuf:"bytes,4,rep,name=damage_ledger,json=damageLedger,proto3" json:"damage_ledger,omitempty"`
+}
+
+func (x *PlayerSnapshot) Reset() {
+	*x = PlayerSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSnapshot) ProtoMessage() {}
+
+func (x *PlayerSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSnapshot.ProtoReflect.Descriptor instead.
+func (*PlayerSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PlayerSnapshot) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *PlayerSnapshot) GetBaseAttributes() *Attributes {
+	if x != nil {
+		return x.BaseAttributes
+	}
+	return nil
+}
+
+func (x *PlayerSnapshot) GetMovingTo() *PathSnapshot {
+	if x != nil {
+		return x.MovingTo
+	}
+	return nil
+}
+
+func (x *PlayerSnapshot) GetDamageLedger() []*DamageRecord {
+	if x != nil {
+		return x.DamageLedger
+	}
+	return nil
+}
+
+type PathSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells        []*Position `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	CurrentIndex int32       `protobuf:"varint,2,opt,name=current_index,json=currentIndex,proto3" json:"current_index,omitempty"`
+}
+
+func (x *PathSnapshot) Reset() {
+	*x = PathSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathSnapshot) ProtoMessage() {}
+
+func (x *PathSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathSnapshot.ProtoReflect.Descriptor instead.
+func (*PathSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PathSnapshot) GetCells() []*Position {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *PathSnapshot) GetCurrentIndex() int32 {
+	if x != nil {
+		return x.CurrentIndex
+	}
+	return 0
+}
+
+type ProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+}
+
+func (x *ProfileRequest) Reset() {
+	*x = ProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileRequest) ProtoMessage() {}
+
+func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type PlayerProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kills         int32   `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths        int32   `protobuf:"varint,3,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	DeepestLevel  int32   `protobuf:"varint,4,opt,name=deepest_level,json=deepestLevel,proto3" json:"deepest_level,omitempty"`
+	DamageDealt   float32 `protobuf:"fixed32,5,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	DamageTaken   float32 `protobuf:"fixed32,6,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
+	MoneyReceived int32   `protobuf:"varint,7,opt,name=money_received,json=moneyReceived,proto3" json:"money_received,omitempty"`
+	TicksAlive    int32   `protobuf:"varint,8,opt,name=ticks_alive,json=ticksAlive,proto3" json:"ticks_alive,omitempty"`
+	Assists       int32   `protobuf:"varint,9,opt,name=assists,proto3" json:"assists,omitempty"`
+}
+
+func (x *PlayerProfile) Reset() {
+	*x = PlayerProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProfile) ProtoMessage() {}
+
+func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProfile.ProtoReflect.Descriptor instead.
+func (*PlayerProfile) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PlayerProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetDeepestLevel() int32 {
+	if x != nil {
+		return x.DeepestLevel
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetDamageDealt() float32 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetDamageTaken() float32 {
+	if x != nil {
+		return x.DamageTaken
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetMoneyReceived() int32 {
+	if x != nil {
+		return x.MoneyReceived
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetTicksAlive() int32 {
+	if x != nil {
+		return x.TicksAlive
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Measure   LeaderboardRequest_Measure `protobuf:"varint,1,opt,name=measure,proto3,enum=dungeonsandtrolls.LeaderboardRequest_Measure" json:"measure,omitempty"`
+	Scope     LeaderboardRequest_Scope   `protobuf:"varint,2,opt,name=scope,proto3,enum=dungeonsandtrolls.LeaderboardRequest_Scope" json:"scope,omitempty"`
+	SinceTick *int32                     `protobuf:"varint,3,opt,name=since_tick,json=sinceTick,proto3,oneof" json:"since_tick,omitempty"`
+	UntilTick *int32                     `protobuf:"varint,4,opt,name=until_tick,json=untilTick,proto3,oneof" json:"until_tick,omitempty"`
+	Limit     *int32                     `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *LeaderboardRequest) GetMeasure() LeaderboardRequest_Measure {
+	if x != nil {
+		return x.Measure
+	}
+	return LeaderboardRequest_DEEPEST_LEVEL
+}
+
+func (x *LeaderboardRequest) GetScope() LeaderboardRequest_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return LeaderboardRequest_RUN
+}
+
+func (x *LeaderboardRequest) GetSinceTick() int32 {
+	if x != nil && x.SinceTick != nil {
+		return *x.SinceTick
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetUntilTick() int32 {
+	if x != nil && x.UntilTick != nil {
+		return *x.UntilTick
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DeepestLevel int32   `protobuf:"varint,2,opt,name=deepest_level,json=deepestLevel,proto3" json:"deepest_level,omitempty"`
+	Kills        int32   `protobuf:"varint,3,opt,name=kills,proto3" json:"kills,omitempty"`
+	Score        float32 `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`
+	TicksAlive   int32   `protobuf:"varint,5,opt,name=ticks_alive,json=ticksAlive,proto3" json:"ticks_alive,omitempty"`
+	DamageDealt  float32 `protobuf:"fixed32,6,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	SinceTick    int32   `protobuf:"varint,7,opt,name=since_tick,json=sinceTick,proto3" json:"since_tick,omitempty"`
+	Assists      int32   `protobuf:"varint,8,opt,name=assists,proto3" json:"assists,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PlayerStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetDeepestLevel() int32 {
+	if x != nil {
+		return x.DeepestLevel
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetTicksAlive() int32 {
+	if x != nil {
+		return x.TicksAlive
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDamageDealt() float32 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetSinceTick() int32 {
+	if x != nil {
+		return x.SinceTick
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+type Ranking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries   []*Ranking_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	SinceTick *int32           `protobuf:"varint,2,opt,name=since_tick,json=sinceTick,proto3,oneof" json:"since_tick,omitempty"`
+	UntilTick *int32           `protobuf:"varint,3,opt,name=until_tick,json=untilTick,proto3,oneof" json:"until_tick,omitempty"`
+}
+
+func (x *Ranking) Reset() {
+	*x = Ranking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ranking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ranking) ProtoMessage() {}
+
+func (x *Ranking) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ranking.ProtoReflect.Descriptor instead.
+func (*Ranking) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Ranking) GetEntries() []*Ranking_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Ranking) GetSinceTick() int32 {
+	if x != nil && x.SinceTick != nil {
+		return *x.SinceTick
+	}
+	return 0
+}
+
+func (x *Ranking) GetUntilTick() int32 {
+	if x != nil && x.UntilTick != nil {
+		return *x.UntilTick
+	}
+	return 0
+}
+
+type Ranking_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank  int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Name  string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value float32 `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Ranking_Entry) Reset() {
+	*x = Ranking_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dungeonsandtrolls_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ranking_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ranking_Entry) ProtoMessage() {}
+
+func (x *Ranking_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dungeonsandtrolls_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ranking_Entry.ProtoReflect.Descriptor instead.
+func (*Ranking_Entry) Descriptor() ([]byte, []int) {
+	return file_proto_dungeonsandtrolls_proto_rawDescGZIP(), []int{84, 0}
+}
+
+func (x *Ranking_Entry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Ranking_Entry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ranking_Entry) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+var File_proto_dungeonsandtrolls_proto protoreflect.FileDescriptor
+
+var file_proto_dungeonsandtrolls_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x11, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x01,
+	0x0a, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x40, 0x0a,
+	0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x7b, 0x0a,
+	0x12, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x08,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x73, 0x0a, 0x11, 0x52, 0x65,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x30, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22,
+	0x7c, 0x0a, 0x12, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x75,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
+	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x6b, 0x69,
+	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x77, 0x0a,
+	0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e,
+	0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x08, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5a, 0x0a, 0x15, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x4d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3d,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x3d, 0x0a, 0x0d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x08,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x1d, 0x0a, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x63,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x63, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x1c, 0x0a,
 	0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
@@ -3957,7 +6952,7 @@ var file_proto_dungeonsandtrolls_proto_rawDesc = []byte{
 	0x64, 0x6f, 0x6f, 0x72, 0x73, 0x22, 0x37, 0x0a, 0x08, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e,
 	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x22, 0x96,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x22, 0xc9,
 	0x01, 0x0a, 0x0f, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
@@ -3965,51 +6960,135 @@ var file_proto_dungeonsandtrolls_proto_rawDesc = []byte{
 	0x28, 0x08, 0x48, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21,
 	0x0a, 0x0a, 0x66, 0x6f, 0x67, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x66, 0x6f, 0x67, 0x4f, 0x66, 0x57, 0x61, 0x72, 0x88, 0x01,
-	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x6f, 0x67,
-	0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x61, 0x72, 0x22, 0x29, 0x0a, 0x0f, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x08, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x66, 0x6f, 0x67, 0x5f, 0x6f,
-	0x66, 0x5f, 0x77, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x66,
-	0x6f, 0x67, 0x4f, 0x66, 0x57, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x6f, 0x67, 0x5f,
-	0x6f, 0x66, 0x5f, 0x77, 0x61, 0x72, 0x22, 0xc2, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x03, 0x62, 0x75, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
-	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x03, 0x62, 0x75, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x3b, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48,
-	0x01, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x04,
-	0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e,
-	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x48, 0x03,
-	0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x79, 0x65,
-	0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
-	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x48, 0x04, 0x52, 0x04, 0x79, 0x65, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12,
-	0x52, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x66, 0x6f, 0x67, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x61, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x29, 0x0a, 0x0f, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f,
+	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x66, 0x6f,
+	0x67, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02,
+	0x52, 0x08, 0x66, 0x6f, 0x67, 0x4f, 0x66, 0x57, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x66, 0x6f, 0x67, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x61, 0x72, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x22, 0xc2, 0x03, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35,
+	0x0a, 0x03, 0x62, 0x75, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75,
+	0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x03, 0x62,
+	0x75, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x01, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x88,
+	0x01, 0x01, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52,
+	0x04, 0x6d, 0x6f, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x48, 0x03, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x04, 0x79, 0x65, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x04, 0x52, 0x04, 0x79, 0x65,
+	0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f,
+	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x48, 0x05, 0x52, 0x11, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x6b, 0x69, 0x6c, 0x6c,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x62, 0x75,
+	0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x79, 0x65, 0x6c, 0x6c, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xc7, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x22, 0x4d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x4f, 0x50,
+	0x50, 0x45, 0x44, 0x10, 0x04, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b,
+	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x22, 0x3e, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xee, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x39, 0x0a, 0x04,
+	0x79, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x04,
+	0x79, 0x65, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x01, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x37, 0x0a, 0x03, 0x62, 0x75, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64,
 	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
-	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x48, 0x05, 0x52, 0x11, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x62, 0x75, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x76, 0x65,
-	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x79,
-	0x65, 0x6c, 0x6c, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73,
-	0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x13,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x03,
+	0x52, 0x03, 0x62, 0x75, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x6b,
+	0x5f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x04, 0x52, 0x06, 0x70,
+	0x69, 0x63, 0x6b, 0x55, 0x70, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x05, 0x52, 0x11, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x79, 0x65, 0x6c, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x6b, 0x69,
+	0x6c, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x62, 0x75, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x42,
+	0x16, 0x0a, 0x14, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x13,
 	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x6f, 0x6e, 0x73, 0x74,
 	0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
@@ -4461,7 +7540,7 @@ var file_proto_dungeonsandtrolls_proto_rawDesc = []byte{
 	0x03, 0x4d, 0x61, 0x70, 0x12, 0x30, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
 	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x06,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x97, 0x05, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x97, 0x07, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
 	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
@@ -4488,158 +7567,681 @@ var file_proto_dungeonsandtrolls_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x48,
 	0x07, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x69,
 	0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x88, 0x01, 0x01, 0x22, 0x72, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x42, 0x55, 0x59, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55, 0x49, 0x50,
-	0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a,
-	0x05, 0x53, 0x4b, 0x49, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x41, 0x54,
-	0x48, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x07, 0x12, 0x08,
-	0x0a, 0x04, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x08, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4f, 0x45, 0x10,
-	0x09, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
-	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64,
-	0x61, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x6b, 0x69,
-	0x6c, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x64,
-	0x22, 0xd3, 0x03, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28,
-	0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x75,
+	0x52, 0x08, 0x69, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x09, 0x52, 0x08, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x48, 0x0a,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75,
 	0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e,
-	0x4d, 0x61, 0x70, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70,
-	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
-	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0x3f, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e,
-	0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x88, 0x01,
-	0x01, 0x12, 0x4b, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75,
+	0x4b, 0x69, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x08, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x08, 0x76, 0x69, 0x63, 0x74,
+	0x69, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x22, 0x83, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x59,
+	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55, 0x49, 0x50, 0x10, 0x03, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4b, 0x49, 0x4c,
+	0x4c, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x41, 0x54, 0x48, 0x10, 0x06, 0x12, 0x09,
+	0x0a, 0x05, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x4f, 0x56,
+	0x45, 0x10, 0x08, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4f, 0x45, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x10, 0x0a, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x5f, 0x69, 0x64,
+	0x22, 0x92, 0x01, 0x0a, 0x0a, 0x4b, 0x69, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6b, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x77,
+	0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x57, 0x0a, 0x0c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0xfc,
+	0x04, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x03,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4d, 0x61,
+	0x70, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3f,
+	0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x4b, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x3c, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x48, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xe5, 0x01,
+	0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12,
+	0x4b, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x22, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x0a, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x0b, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x22, 0xa0, 0x01, 0x0a, 0x08,
+	0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x6c,
+	0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38,
+	0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xb0, 0x01, 0x0a, 0x0a, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x07, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
+	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x35, 0x0a, 0x0e, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x22, 0x4a, 0x0a, 0x0d, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x35, 0x0a, 0x11,
+	0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x62, 0x61, 0x6e, 0x22, 0x63, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75,
+	0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0c, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xb7,
+	0x01, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x6b,
+	0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x01, 0x52, 0x0b, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x02, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x7a, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x22, 0x8e, 0x02, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x3c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a,
+	0x07, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e,
+	0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xc8, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x74,
+	0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x64, 0x75,
 	0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28,
-	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64,
+	0x53, 0x65, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54,
+	0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x34, 0x0a,
+	0x08, 0x54, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x52, 0x45,
+	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x44, 0x4f, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x49, 0x52,
+	0x53, 0x10, 0x03, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x06,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
+	0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x22,
+	0x9d, 0x01, 0x0a, 0x0b, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22,
+	0x44, 0x0a, 0x0c, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x34, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe4, 0x03, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x62, 0x61,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x6e, 0x65, 0x79,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x6f, 0x6e,
+	0x65, 0x79, 0x42, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x10, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x78,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66,
+	0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x62, 0x61, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x23, 0x0a, 0x0b,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x22, 0x20, 0x0a, 0x0a, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x22, 0xd9, 0x03, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x06, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x73, 0x2e, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x08, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64,
 	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x42,
-	0x13, 0x0a, 0x11, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x22, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x0a, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x0b, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x0b, 0x43, 0x6f, 0x6f,
-	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x22, 0xa0, 0x01, 0x0a,
-	0x08, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6b, 0x69,
-	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69,
-	0x6c, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x73,
+	0x1a, 0x5d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xd9, 0x01, 0x0a, 0x0d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x0a, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xd0, 0x02, 0x0a, 0x0f,
+	0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x6b,
+	0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x54,
+	0x6f, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x75, 0x6d, 0x6d, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x75, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x0c, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0x98,
+	0x02, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
+	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a,
+	0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
+	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x61, 0x74,
+	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x54, 0x6f, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0c, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x0c, 0x50, 0x61, 0x74,
+	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
 	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x38, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1c, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x2a, 0x51, 0x0a, 0x0a, 0x44, 0x61, 0x6d,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x70, 0x69, 0x65, 0x72, 0x63, 0x65, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x66, 0x69, 0x72, 0x65,
-	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x73, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x0c,
-	0x0a, 0x08, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x69, 0x63, 0x10, 0x05, 0x32, 0xe6, 0x08, 0x0a,
-	0x11, 0x44, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x41, 0x6e, 0x64, 0x54, 0x72, 0x6f, 0x6c,
-	0x6c, 0x73, 0x12, 0x4a, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x75, 0x6e,
-	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1c,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x32, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9e, 0x02, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b,
+	0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x65,
+	0x70, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x64, 0x65, 0x65, 0x70, 0x65, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x6b, 0x65,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x54,
+	0x61, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x69, 0x63, 0x6b, 0x73, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x22, 0xac, 0x03, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a,
+	0x07, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
 	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x54,
-	0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x2e, 0x64, 0x75,
-	0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x1a, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
-	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
-	0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x1a, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x06, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x2e,
+	0x6c, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x09, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x88, 0x01,
+	0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x22, 0x5f, 0x0a, 0x07,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x45, 0x50, 0x45,
+	0x53, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x49,
+	0x4c, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x02,
+	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x55, 0x52, 0x56, 0x49, 0x56, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x4c, 0x54, 0x10, 0x04,
+	0x12, 0x0b, 0x0a, 0x07, 0x41, 0x53, 0x53, 0x49, 0x53, 0x54, 0x53, 0x10, 0x05, 0x22, 0x1e, 0x0a,
+	0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x55, 0x4e, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x65,
+	0x70, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x64, 0x65, 0x65, 0x70, 0x65, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b,
+	0x69, 0x6c, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69,
+	0x63, 0x6b, 0x73, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
+	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x54, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x1a, 0x45, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x2a, 0xac, 0x03, 0x0a,
+	0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x54, 0x55, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x5f,
+	0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x4f,
+	0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x49, 0x47, 0x48, 0x54, 0x10, 0x04,
+	0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x53, 0x55,
+	0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x51, 0x55, 0x49,
+	0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x10,
+	0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08,
+	0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x41, 0x52, 0x47,
+	0x45, 0x54, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f,
+	0x5f, 0x50, 0x41, 0x54, 0x48, 0x10, 0x0b, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x53, 0x10,
+	0x0c, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x41, 0x54, 0x10, 0x0d,
+	0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e,
+	0x10, 0x0f, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x10, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x11, 0x12, 0x12,
+	0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53,
+	0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x13, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x14, 0x2a, 0x51, 0x0a, 0x0a, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e,
+	0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x70, 0x69, 0x65, 0x72, 0x63, 0x65, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x66, 0x69,
+	0x72, 0x65, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x73, 0x6f, 0x6e, 0x10, 0x04,
+	0x12, 0x0c, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x69, 0x63, 0x10, 0x05, 0x32, 0xac,
+	0x0e, 0x0a, 0x11, 0x44, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x41, 0x6e, 0x64, 0x54, 0x72,
+	0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x4a, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x64,
+	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x1a, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x2e,
 	0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
-	0x22, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0x17, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x1f, 0x2e, 0x64, 0x75, 0x6e,
-	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x03, 0x42, 0x75, 0x79, 0x12, 0x28, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
-	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x50, 0x69, 0x63, 0x6b,
-	0x55, 0x70, 0x12, 0x27, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x25, 0x2e,
+	0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x1a, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e,
+	0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1c, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x07, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
+	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x06, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x22, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
+	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x08, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a,
+	0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x03, 0x42, 0x75, 0x79, 0x12, 0x28, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e,
+	0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x06, 0x50, 0x69, 0x63, 0x6b, 0x55,
+	0x70, 0x12, 0x27, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x25, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e,
 	0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49,
-	0x0a, 0x07, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x24, 0x2e, 0x64, 0x75, 0x6e, 0x67,
-	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x05, 0x53, 0x6b, 0x69,
-	0x6c, 0x6c, 0x12, 0x25, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x57,
-	0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x04, 0x59, 0x65, 0x6c, 0x6c, 0x12, 0x24, 0x2e, 0x64, 0x75,
-	0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x08, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
-	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x24, 0x2e, 0x64,
+	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x05,
+	0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x25, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x55,
+	0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e, 0x64,
+	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x04, 0x59, 0x65, 0x6c, 0x6c, 0x12, 0x24, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
+	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x2a,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x04, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
+	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5e, 0x0a,
 	0x10, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
 	0x73, 0x12, 0x30, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
 	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x46, 0x6f,
 	0x72, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a,
 	0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
 	0x74, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
 	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x64, 0x67, 0x2d, 0x67, 0x61, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x64,
-	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
-	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x20, 0x2e, 0x64, 0x75,
+	0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64,
+	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
+	0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61,
+	0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x21, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65,
+	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x32, 0xc6, 0x08,
+	0x0a, 0x16, 0x44, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x41, 0x6e, 0x64, 0x54, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x0e, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x22, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e,
+	0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x05, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f,
+	0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x47, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e,
+	0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x1a, 0x19, 0x2e, 0x64,
+	0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x53, 0x70, 0x61,
+	0x77, 0x6e, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x64, 0x75, 0x6e, 0x67,
+	0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x70,
+	0x61, 0x77, 0x6e, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x08, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x21,
+	0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x64, 0x75, 0x6e,
+	0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e,
+	0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1e, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73,
+	0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x64, 0x67, 0x2d, 0x67, 0x61, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x64, 0x75, 0x6e, 0x67, 0x65, 0x6f, 0x6e, 0x73, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x75, 0x6e, 0x67, 0x65,
+	0x6f, 0x6e, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4654,195 +8256,334 @@ func file_proto_dungeonsandtrolls_proto_rawDescGZIP() []byte {
 	return file_proto_dungeonsandtrolls_proto_rawDescData
 }
 
-var file_proto_dungeonsandtrolls_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_dungeonsandtrolls_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_proto_dungeonsandtrolls_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_dungeonsandtrolls_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_proto_dungeonsandtrolls_proto_goTypes = []interface{}{
-	(DamageType)(0),                       // 0: dungeonsandtrolls.DamageType
-	(Skill_Target)(0),                     // 1: dungeonsandtrolls.Skill.Target
-	(Item_Type)(0),                        // 2: dungeonsandtrolls.Item.Type
-	(Event_Type)(0),                       // 3: dungeonsandtrolls.Event.Type
-	(*IdentifierWithParams)(nil),          // 4: dungeonsandtrolls.IdentifierWithParams
-	(*IdentifiersWithParams)(nil),         // 5: dungeonsandtrolls.IdentifiersWithParams
-	(*PositionWithParams)(nil),            // 6: dungeonsandtrolls.PositionWithParams
-	(*RespawnWithParams)(nil),             // 7: dungeonsandtrolls.RespawnWithParams
-	(*SkillUseWithParams)(nil),            // 8: dungeonsandtrolls.SkillUseWithParams
-	(*MessageWithParams)(nil),             // 9: dungeonsandtrolls.MessageWithParams
-	(*CommandsBatchWithParams)(nil),       // 10: dungeonsandtrolls.CommandsBatchWithParams
-	(*CommandsForMonstersWithParams)(nil), // 11: dungeonsandtrolls.CommandsForMonstersWithParams
-	(*AttributesWithParams)(nil),          // 12: dungeonsandtrolls.AttributesWithParams
-	(*PlayersParams)(nil),                 // 13: dungeonsandtrolls.PlayersParams
-	(*Message)(nil),                       // 14: dungeonsandtrolls.Message
-	(*Decoration)(nil),                    // 15: dungeonsandtrolls.Decoration
-	(*Position)(nil),                      // 16: dungeonsandtrolls.Position
-	(*Key)(nil),                           // 17: dungeonsandtrolls.Key
-	(*Waypoint)(nil),                      // 18: dungeonsandtrolls.Waypoint
-	(*GameStateParams)(nil),               // 19: dungeonsandtrolls.GameStateParams
-	(*AvailableLevels)(nil),               // 20: dungeonsandtrolls.AvailableLevels
-	(*GameStateParamsLevel)(nil),          // 21: dungeonsandtrolls.GameStateParamsLevel
-	(*CommandsBatch)(nil),                 // 22: dungeonsandtrolls.CommandsBatch
-	(*CommandsForMonsters)(nil),           // 23: dungeonsandtrolls.CommandsForMonsters
-	(*Effect)(nil),                        // 24: dungeonsandtrolls.Effect
-	(*Attributes)(nil),                    // 25: dungeonsandtrolls.Attributes
-	(*SkillAttributes)(nil),               // 26: dungeonsandtrolls.SkillAttributes
-	(*Stats)(nil),                         // 27: dungeonsandtrolls.Stats
-	(*Stun)(nil),                          // 28: dungeonsandtrolls.Stun
-	(*Monster)(nil),                       // 29: dungeonsandtrolls.Monster
-	(*Character)(nil),                     // 30: dungeonsandtrolls.Character
-	(*PlayersInfo)(nil),                   // 31: dungeonsandtrolls.PlayersInfo
-	(*Skill)(nil),                         // 32: dungeonsandtrolls.Skill
-	(*Item)(nil),                          // 33: dungeonsandtrolls.Item
-	(*SimpleItem)(nil),                    // 34: dungeonsandtrolls.SimpleItem
-	(*Droppable)(nil),                     // 35: dungeonsandtrolls.Droppable
-	(*SkillGenericFlags)(nil),             // 36: dungeonsandtrolls.SkillGenericFlags
-	(*SkillSpecificFlags)(nil),            // 37: dungeonsandtrolls.SkillSpecificFlags
-	(*SkillEffect)(nil),                   // 38: dungeonsandtrolls.SkillEffect
-	(*Shortcut)(nil),                      // 39: dungeonsandtrolls.Shortcut
-	(*MapObjects)(nil),                    // 40: dungeonsandtrolls.MapObjects
-	(*Level)(nil),                         // 41: dungeonsandtrolls.Level
-	(*PlayerSpecificMap)(nil),             // 42: dungeonsandtrolls.PlayerSpecificMap
-	(*FogOfWarMap)(nil),                   // 43: dungeonsandtrolls.FogOfWarMap
-	(*Map)(nil),                           // 44: dungeonsandtrolls.Map
-	(*Event)(nil),                         // 45: dungeonsandtrolls.Event
-	(*GameState)(nil),                     // 46: dungeonsandtrolls.GameState
-	(*User)(nil),                          // 47: dungeonsandtrolls.User
-	(*Identifier)(nil),                    // 48: dungeonsandtrolls.Identifier
-	(*Identifiers)(nil),                   // 49: dungeonsandtrolls.Identifiers
-	(*Coordinates)(nil),                   // 50: dungeonsandtrolls.Coordinates
-	(*SkillUse)(nil),                      // 51: dungeonsandtrolls.SkillUse
-	(*Registration)(nil),                  // 52: dungeonsandtrolls.Registration
-	nil,                                   // 53: dungeonsandtrolls.CommandsForMonsters.CommandsEntry
-	(*emptypb.Empty)(nil),                 // 54: google.protobuf.Empty
+	(ErrorCode)(0),                        // 0: dungeonsandtrolls.ErrorCode
+	(DamageType)(0),                       // 1: dungeonsandtrolls.DamageType
+	(CommandsBatchStatus_Status)(0),       // 2: dungeonsandtrolls.CommandsBatchStatus.Status
+	(CommandStatus_Status)(0),             // 3: dungeonsandtrolls.CommandStatus.Status
+	(Skill_Target)(0),                     // 4: dungeonsandtrolls.Skill.Target
+	(Item_Type)(0),                        // 5: dungeonsandtrolls.Item.Type
+	(Event_Type)(0),                       // 6: dungeonsandtrolls.Event.Type
+	(SetTileRequest_TileType)(0),          // 7: dungeonsandtrolls.SetTileRequest.TileType
+	(LeaderboardRequest_Measure)(0),       // 8: dungeonsandtrolls.LeaderboardRequest.Measure
+	(LeaderboardRequest_Scope)(0),         // 9: dungeonsandtrolls.LeaderboardRequest.Scope
+	(*IdentifierWithParams)(nil),          // 10: dungeonsandtrolls.IdentifierWithParams
+	(*IdentifiersWithParams)(nil),         // 11: dungeonsandtrolls.IdentifiersWithParams
+	(*PositionWithParams)(nil),            // 12: dungeonsandtrolls.PositionWithParams
+	(*RespawnWithParams)(nil),             // 13: dungeonsandtrolls.RespawnWithParams
+	(*SkillUseWithParams)(nil),            // 14: dungeonsandtrolls.SkillUseWithParams
+	(*MessageWithParams)(nil),             // 15: dungeonsandtrolls.MessageWithParams
+	(*CommandsBatchWithParams)(nil),       // 16: dungeonsandtrolls.CommandsBatchWithParams
+	(*CommandsForMonstersWithParams)(nil), // 17: dungeonsandtrolls.CommandsForMonstersWithParams
+	(*AttributesWithParams)(nil),          // 18: dungeonsandtrolls.AttributesWithParams
+	(*PlayersParams)(nil),                 // 19: dungeonsandtrolls.PlayersParams
+	(*Message)(nil),                       // 20: dungeonsandtrolls.Message
+	(*Decoration)(nil),                    // 21: dungeonsandtrolls.Decoration
+	(*Position)(nil),                      // 22: dungeonsandtrolls.Position
+	(*Key)(nil),                           // 23: dungeonsandtrolls.Key
+	(*Waypoint)(nil),                      // 24: dungeonsandtrolls.Waypoint
+	(*GameStateParams)(nil),               // 25: dungeonsandtrolls.GameStateParams
+	(*AvailableLevels)(nil),               // 26: dungeonsandtrolls.AvailableLevels
+	(*GameStateParamsLevel)(nil),          // 27: dungeonsandtrolls.GameStateParamsLevel
+	(*CommandsBatch)(nil),                 // 28: dungeonsandtrolls.CommandsBatch
+	(*CommandsBatchStatus)(nil),           // 29: dungeonsandtrolls.CommandsBatchStatus
+	(*CommandStatus)(nil),                 // 30: dungeonsandtrolls.CommandStatus
+	(*CommandResult)(nil),                 // 31: dungeonsandtrolls.CommandResult
+	(*PlayUpdate)(nil),                    // 32: dungeonsandtrolls.PlayUpdate
+	(*CommandsForMonsters)(nil),           // 33: dungeonsandtrolls.CommandsForMonsters
+	(*Effect)(nil),                        // 34: dungeonsandtrolls.Effect
+	(*Attributes)(nil),                    // 35: dungeonsandtrolls.Attributes
+	(*SkillAttributes)(nil),               // 36: dungeonsandtrolls.SkillAttributes
+	(*Stats)(nil),                         // 37: dungeonsandtrolls.Stats
+	(*Stun)(nil),                          // 38: dungeonsandtrolls.Stun
+	(*Monster)(nil),                       // 39: dungeonsandtrolls.Monster
+	(*Character)(nil),                     // 40: dungeonsandtrolls.Character
+	(*PlayersInfo)(nil),                   // 41: dungeonsandtrolls.PlayersInfo
+	(*Skill)(nil),                         // 42: dungeonsandtrolls.Skill
+	(*Item)(nil),                          // 43: dungeonsandtrolls.Item
+	(*SimpleItem)(nil),                    // 44: dungeonsandtrolls.SimpleItem
+	(*Droppable)(nil),                     // 45: dungeonsandtrolls.Droppable
+	(*SkillGenericFlags)(nil),             // 46: dungeonsandtrolls.SkillGenericFlags
+	(*SkillSpecificFlags)(nil),            // 47: dungeonsandtrolls.SkillSpecificFlags
+	(*SkillEffect)(nil),                   // 48: dungeonsandtrolls.SkillEffect
+	(*Shortcut)(nil),                      // 49: dungeonsandtrolls.Shortcut
+	(*MapObjects)(nil),                    // 50: dungeonsandtrolls.MapObjects
+	(*Level)(nil),                         // 51: dungeonsandtrolls.Level
+	(*PlayerSpecificMap)(nil),             // 52: dungeonsandtrolls.PlayerSpecificMap
+	(*FogOfWarMap)(nil),                   // 53: dungeonsandtrolls.FogOfWarMap
+	(*Map)(nil),                           // 54: dungeonsandtrolls.Map
+	(*Event)(nil),                         // 55: dungeonsandtrolls.Event
+	(*KillCredit)(nil),                    // 56: dungeonsandtrolls.KillCredit
+	(*DamageRecord)(nil),                  // 57: dungeonsandtrolls.DamageRecord
+	(*GameState)(nil),                     // 58: dungeonsandtrolls.GameState
+	(*GameStateDelta)(nil),                // 59: dungeonsandtrolls.GameStateDelta
+	(*User)(nil),                          // 60: dungeonsandtrolls.User
+	(*Identifier)(nil),                    // 61: dungeonsandtrolls.Identifier
+	(*Identifiers)(nil),                   // 62: dungeonsandtrolls.Identifiers
+	(*Coordinates)(nil),                   // 63: dungeonsandtrolls.Coordinates
+	(*SkillUse)(nil),                      // 64: dungeonsandtrolls.SkillUse
+	(*Registration)(nil),                  // 65: dungeonsandtrolls.Registration
+	(*ApiKeyInfo)(nil),                    // 66: dungeonsandtrolls.ApiKeyInfo
+	(*ApiKeys)(nil),                       // 67: dungeonsandtrolls.ApiKeys
+	(*ApiKeysRequest)(nil),                // 68: dungeonsandtrolls.ApiKeysRequest
+	(*ApiKeyRequest)(nil),                 // 69: dungeonsandtrolls.ApiKeyRequest
+	(*KickPlayerRequest)(nil),             // 70: dungeonsandtrolls.KickPlayerRequest
+	(*TeleportRequest)(nil),               // 71: dungeonsandtrolls.TeleportRequest
+	(*LevelRequest)(nil),                  // 72: dungeonsandtrolls.LevelRequest
+	(*GrantRequest)(nil),                  // 73: dungeonsandtrolls.GrantRequest
+	(*GameProgress)(nil),                  // 74: dungeonsandtrolls.GameProgress
+	(*Entity)(nil),                        // 75: dungeonsandtrolls.Entity
+	(*SpawnMonsterRequest)(nil),           // 76: dungeonsandtrolls.SpawnMonsterRequest
+	(*DropItemRequest)(nil),               // 77: dungeonsandtrolls.DropItemRequest
+	(*SetTileRequest)(nil),                // 78: dungeonsandtrolls.SetTileRequest
+	(*PlaceEffectRequest)(nil),            // 79: dungeonsandtrolls.PlaceEffectRequest
+	(*ApiKeyUsage)(nil),                   // 80: dungeonsandtrolls.ApiKeyUsage
+	(*ApiKeysUsage)(nil),                  // 81: dungeonsandtrolls.ApiKeysUsage
+	(*Balance)(nil),                       // 82: dungeonsandtrolls.Balance
+	(*StepRequest)(nil),                   // 83: dungeonsandtrolls.StepRequest
+	(*StepResult)(nil),                    // 84: dungeonsandtrolls.StepResult
+	(*WorldSnapshot)(nil),                 // 85: dungeonsandtrolls.WorldSnapshot
+	(*LevelSnapshot)(nil),                 // 86: dungeonsandtrolls.LevelSnapshot
+	(*MonsterSnapshot)(nil),               // 87: dungeonsandtrolls.MonsterSnapshot
+	(*PlayerSnapshot)(nil),                // 88: dungeonsandtrolls.PlayerSnapshot
+	(*PathSnapshot)(nil),                  // 89: dungeonsandtrolls.PathSnapshot
+	(*ProfileRequest)(nil),                // 90: dungeonsandtrolls.ProfileRequest
+	(*PlayerProfile)(nil),                 // 91: dungeonsandtrolls.PlayerProfile
+	(*LeaderboardRequest)(nil),            // 92: dungeonsandtrolls.LeaderboardRequest
+	(*PlayerStats)(nil),                   // 93: dungeonsandtrolls.PlayerStats
+	(*Ranking)(nil),                       // 94: dungeonsandtrolls.Ranking
+	nil,                                   // 95: dungeonsandtrolls.CommandsForMonsters.CommandsEntry
+	nil,                                   // 96: dungeonsandtrolls.WorldSnapshot.CommandsEntry
+	(*Ranking_Entry)(nil),                 // 97: dungeonsandtrolls.Ranking.Entry
+	(*emptypb.Empty)(nil),                 // 98: google.protobuf.Empty
 }
 var file_proto_dungeonsandtrolls_proto_depIdxs = []int32{
-	48,  // 0: dungeonsandtrolls.IdentifierWithParams.identifier:type_name -> dungeonsandtrolls.Identifier
-	49,  // 1: dungeonsandtrolls.IdentifiersWithParams.identifiers:type_name -> dungeonsandtrolls.Identifiers
-	16,  // 2: dungeonsandtrolls.PositionWithParams.position:type_name -> dungeonsandtrolls.Position
-	54,  // 3: dungeonsandtrolls.RespawnWithParams.respawn:type_name -> google.protobuf.Empty
-	51,  // 4: dungeonsandtrolls.SkillUseWithParams.skill_use:type_name -> dungeonsandtrolls.SkillUse
-	14,  // 5: dungeonsandtrolls.MessageWithParams.message:type_name -> dungeonsandtrolls.Message
-	22,  // 6: dungeonsandtrolls.CommandsBatchWithParams.commands_batch:type_name -> dungeonsandtrolls.CommandsBatch
-	23,  // 7: dungeonsandtrolls.CommandsForMonstersWithParams.commands_for_monsters:type_name -> dungeonsandtrolls.CommandsForMonsters
-	25,  // 8: dungeonsandtrolls.AttributesWithParams.attributes:type_name -> dungeonsandtrolls.Attributes
-	16,  // 9: dungeonsandtrolls.Key.doors:type_name -> dungeonsandtrolls.Position
-	49,  // 10: dungeonsandtrolls.CommandsBatch.buy:type_name -> dungeonsandtrolls.Identifiers
-	48,  // 11: dungeonsandtrolls.CommandsBatch.pick_up:type_name -> dungeonsandtrolls.Identifier
-	16,  // 12: dungeonsandtrolls.CommandsBatch.move:type_name -> dungeonsandtrolls.Position
-	51,  // 13: dungeonsandtrolls.CommandsBatch.skill:type_name -> dungeonsandtrolls.SkillUse
-	14,  // 14: dungeonsandtrolls.CommandsBatch.yell:type_name -> dungeonsandtrolls.Message
-	25,  // 15: dungeonsandtrolls.CommandsBatch.assign_skill_points:type_name -> dungeonsandtrolls.Attributes
-	53,  // 16: dungeonsandtrolls.CommandsForMonsters.commands:type_name -> dungeonsandtrolls.CommandsForMonsters.CommandsEntry
-	0,   // 17: dungeonsandtrolls.Effect.damage_type:type_name -> dungeonsandtrolls.DamageType
-	25,  // 18: dungeonsandtrolls.Effect.effects:type_name -> dungeonsandtrolls.Attributes
-	25,  // 19: dungeonsandtrolls.SkillAttributes.strength:type_name -> dungeonsandtrolls.Attributes
-	25,  // 20: dungeonsandtrolls.SkillAttributes.dexterity:type_name -> dungeonsandtrolls.Attributes
-	25,  // 21: dungeonsandtrolls.SkillAttributes.intelligence:type_name -> dungeonsandtrolls.Attributes
-	25,  // 22: dungeonsandtrolls.SkillAttributes.willpower:type_name -> dungeonsandtrolls.Attributes
-	25,  // 23: dungeonsandtrolls.SkillAttributes.constitution:type_name -> dungeonsandtrolls.Attributes
-	25,  // 24: dungeonsandtrolls.SkillAttributes.slash_resist:type_name -> dungeonsandtrolls.Attributes
-	25,  // 25: dungeonsandtrolls.SkillAttributes.pierce_resist:type_name -> dungeonsandtrolls.Attributes
-	25,  // 26: dungeonsandtrolls.SkillAttributes.fire_resist:type_name -> dungeonsandtrolls.Attributes
-	25,  // 27: dungeonsandtrolls.SkillAttributes.poison_resist:type_name -> dungeonsandtrolls.Attributes
-	25,  // 28: dungeonsandtrolls.SkillAttributes.electric_resist:type_name -> dungeonsandtrolls.Attributes
-	25,  // 29: dungeonsandtrolls.SkillAttributes.life:type_name -> dungeonsandtrolls.Attributes
-	25,  // 30: dungeonsandtrolls.SkillAttributes.stamina:type_name -> dungeonsandtrolls.Attributes
-	25,  // 31: dungeonsandtrolls.SkillAttributes.mana:type_name -> dungeonsandtrolls.Attributes
-	25,  // 32: dungeonsandtrolls.SkillAttributes.constant:type_name -> dungeonsandtrolls.Attributes
-	34,  // 33: dungeonsandtrolls.Monster.items:type_name -> dungeonsandtrolls.SimpleItem
-	24,  // 34: dungeonsandtrolls.Monster.effects:type_name -> dungeonsandtrolls.Effect
-	25,  // 35: dungeonsandtrolls.Monster.attributes:type_name -> dungeonsandtrolls.Attributes
-	33,  // 36: dungeonsandtrolls.Monster.equipped_items:type_name -> dungeonsandtrolls.Item
-	35,  // 37: dungeonsandtrolls.Monster.on_death:type_name -> dungeonsandtrolls.Droppable
-	25,  // 38: dungeonsandtrolls.Monster.max_attributes:type_name -> dungeonsandtrolls.Attributes
-	28,  // 39: dungeonsandtrolls.Monster.stun:type_name -> dungeonsandtrolls.Stun
-	25,  // 40: dungeonsandtrolls.Character.attributes:type_name -> dungeonsandtrolls.Attributes
-	33,  // 41: dungeonsandtrolls.Character.equip:type_name -> dungeonsandtrolls.Item
-	24,  // 42: dungeonsandtrolls.Character.effects:type_name -> dungeonsandtrolls.Effect
-	25,  // 43: dungeonsandtrolls.Character.max_attributes:type_name -> dungeonsandtrolls.Attributes
-	50,  // 44: dungeonsandtrolls.Character.coordinates:type_name -> dungeonsandtrolls.Coordinates
-	28,  // 45: dungeonsandtrolls.Character.stun:type_name -> dungeonsandtrolls.Stun
-	30,  // 46: dungeonsandtrolls.PlayersInfo.players:type_name -> dungeonsandtrolls.Character
-	1,   // 47: dungeonsandtrolls.Skill.target:type_name -> dungeonsandtrolls.Skill.Target
-	25,  // 48: dungeonsandtrolls.Skill.cost:type_name -> dungeonsandtrolls.Attributes
-	25,  // 49: dungeonsandtrolls.Skill.range:type_name -> dungeonsandtrolls.Attributes
-	25,  // 50: dungeonsandtrolls.Skill.radius:type_name -> dungeonsandtrolls.Attributes
-	25,  // 51: dungeonsandtrolls.Skill.duration:type_name -> dungeonsandtrolls.Attributes
-	25,  // 52: dungeonsandtrolls.Skill.damage_amount:type_name -> dungeonsandtrolls.Attributes
-	0,   // 53: dungeonsandtrolls.Skill.damage_type:type_name -> dungeonsandtrolls.DamageType
-	38,  // 54: dungeonsandtrolls.Skill.caster_effects:type_name -> dungeonsandtrolls.SkillEffect
-	38,  // 55: dungeonsandtrolls.Skill.target_effects:type_name -> dungeonsandtrolls.SkillEffect
-	36,  // 56: dungeonsandtrolls.Skill.flags:type_name -> dungeonsandtrolls.SkillGenericFlags
-	2,   // 57: dungeonsandtrolls.Item.slot:type_name -> dungeonsandtrolls.Item.Type
-	25,  // 58: dungeonsandtrolls.Item.requirements:type_name -> dungeonsandtrolls.Attributes
-	25,  // 59: dungeonsandtrolls.Item.attributes:type_name -> dungeonsandtrolls.Attributes
-	32,  // 60: dungeonsandtrolls.Item.skills:type_name -> dungeonsandtrolls.Skill
-	2,   // 61: dungeonsandtrolls.SimpleItem.slot:type_name -> dungeonsandtrolls.Item.Type
-	32,  // 62: dungeonsandtrolls.Droppable.skill:type_name -> dungeonsandtrolls.Skill
-	33,  // 63: dungeonsandtrolls.Droppable.item:type_name -> dungeonsandtrolls.Item
-	29,  // 64: dungeonsandtrolls.Droppable.monster:type_name -> dungeonsandtrolls.Monster
-	15,  // 65: dungeonsandtrolls.Droppable.decoration:type_name -> dungeonsandtrolls.Decoration
-	18,  // 66: dungeonsandtrolls.Droppable.waypoint:type_name -> dungeonsandtrolls.Waypoint
-	17,  // 67: dungeonsandtrolls.Droppable.key:type_name -> dungeonsandtrolls.Key
-	26,  // 68: dungeonsandtrolls.SkillEffect.attributes:type_name -> dungeonsandtrolls.SkillAttributes
-	37,  // 69: dungeonsandtrolls.SkillEffect.flags:type_name -> dungeonsandtrolls.SkillSpecificFlags
-	35,  // 70: dungeonsandtrolls.SkillEffect.summons:type_name -> dungeonsandtrolls.Droppable
-	50,  // 71: dungeonsandtrolls.Shortcut.leads_to:type_name -> dungeonsandtrolls.Coordinates
-	16,  // 72: dungeonsandtrolls.MapObjects.position:type_name -> dungeonsandtrolls.Position
-	29,  // 73: dungeonsandtrolls.MapObjects.monsters:type_name -> dungeonsandtrolls.Monster
-	30,  // 74: dungeonsandtrolls.MapObjects.players:type_name -> dungeonsandtrolls.Character
-	18,  // 75: dungeonsandtrolls.MapObjects.portal:type_name -> dungeonsandtrolls.Waypoint
-	15,  // 76: dungeonsandtrolls.MapObjects.decorations:type_name -> dungeonsandtrolls.Decoration
-	24,  // 77: dungeonsandtrolls.MapObjects.effects:type_name -> dungeonsandtrolls.Effect
-	33,  // 78: dungeonsandtrolls.MapObjects.items:type_name -> dungeonsandtrolls.Item
-	40,  // 79: dungeonsandtrolls.Level.objects:type_name -> dungeonsandtrolls.MapObjects
-	43,  // 80: dungeonsandtrolls.Level.fog_of_war:type_name -> dungeonsandtrolls.FogOfWarMap
-	42,  // 81: dungeonsandtrolls.Level.player_map:type_name -> dungeonsandtrolls.PlayerSpecificMap
-	16,  // 82: dungeonsandtrolls.PlayerSpecificMap.position:type_name -> dungeonsandtrolls.Position
-	16,  // 83: dungeonsandtrolls.FogOfWarMap.position:type_name -> dungeonsandtrolls.Position
-	41,  // 84: dungeonsandtrolls.Map.levels:type_name -> dungeonsandtrolls.Level
-	3,   // 85: dungeonsandtrolls.Event.type:type_name -> dungeonsandtrolls.Event.Type
-	50,  // 86: dungeonsandtrolls.Event.coordinates:type_name -> dungeonsandtrolls.Coordinates
-	50,  // 87: dungeonsandtrolls.Event.target:type_name -> dungeonsandtrolls.Coordinates
-	32,  // 88: dungeonsandtrolls.Event.skill:type_name -> dungeonsandtrolls.Skill
-	44,  // 89: dungeonsandtrolls.GameState.map:type_name -> dungeonsandtrolls.Map
-	33,  // 90: dungeonsandtrolls.GameState.shop_items:type_name -> dungeonsandtrolls.Item
-	30,  // 91: dungeonsandtrolls.GameState.character:type_name -> dungeonsandtrolls.Character
-	16,  // 92: dungeonsandtrolls.GameState.current_position:type_name -> dungeonsandtrolls.Position
-	45,  // 93: dungeonsandtrolls.GameState.events:type_name -> dungeonsandtrolls.Event
-	16,  // 94: dungeonsandtrolls.SkillUse.position:type_name -> dungeonsandtrolls.Position
-	22,  // 95: dungeonsandtrolls.CommandsForMonsters.CommandsEntry.value:type_name -> dungeonsandtrolls.CommandsBatch
-	19,  // 96: dungeonsandtrolls.DungeonsAndTrolls.Game:input_type -> dungeonsandtrolls.GameStateParams
-	21,  // 97: dungeonsandtrolls.DungeonsAndTrolls.GameLevel:input_type -> dungeonsandtrolls.GameStateParamsLevel
-	13,  // 98: dungeonsandtrolls.DungeonsAndTrolls.Players:input_type -> dungeonsandtrolls.PlayersParams
-	13,  // 99: dungeonsandtrolls.DungeonsAndTrolls.Levels:input_type -> dungeonsandtrolls.PlayersParams
-	47,  // 100: dungeonsandtrolls.DungeonsAndTrolls.Register:input_type -> dungeonsandtrolls.User
-	5,   // 101: dungeonsandtrolls.DungeonsAndTrolls.Buy:input_type -> dungeonsandtrolls.IdentifiersWithParams
-	4,   // 102: dungeonsandtrolls.DungeonsAndTrolls.PickUp:input_type -> dungeonsandtrolls.IdentifierWithParams
-	6,   // 103: dungeonsandtrolls.DungeonsAndTrolls.Move:input_type -> dungeonsandtrolls.PositionWithParams
-	7,   // 104: dungeonsandtrolls.DungeonsAndTrolls.Respawn:input_type -> dungeonsandtrolls.RespawnWithParams
-	8,   // 105: dungeonsandtrolls.DungeonsAndTrolls.Skill:input_type -> dungeonsandtrolls.SkillUseWithParams
-	9,   // 106: dungeonsandtrolls.DungeonsAndTrolls.Yell:input_type -> dungeonsandtrolls.MessageWithParams
-	10,  // 107: dungeonsandtrolls.DungeonsAndTrolls.Commands:input_type -> dungeonsandtrolls.CommandsBatchWithParams
-	11,  // 108: dungeonsandtrolls.DungeonsAndTrolls.MonstersCommands:input_type -> dungeonsandtrolls.CommandsForMonstersWithParams
-	12,  // 109: dungeonsandtrolls.DungeonsAndTrolls.AssignSkillPoints:input_type -> dungeonsandtrolls.AttributesWithParams
-	46,  // 110: dungeonsandtrolls.DungeonsAndTrolls.Game:output_type -> dungeonsandtrolls.GameState
-	46,  // 111: dungeonsandtrolls.DungeonsAndTrolls.GameLevel:output_type -> dungeonsandtrolls.GameState
-	31,  // 112: dungeonsandtrolls.DungeonsAndTrolls.Players:output_type -> dungeonsandtrolls.PlayersInfo
-	20,  // 113: dungeonsandtrolls.DungeonsAndTrolls.Levels:output_type -> dungeonsandtrolls.AvailableLevels
-	52,  // 114: dungeonsandtrolls.DungeonsAndTrolls.Register:output_type -> dungeonsandtrolls.Registration
-	54,  // 115: dungeonsandtrolls.DungeonsAndTrolls.Buy:output_type -> google.protobuf.Empty
-	54,  // 116: dungeonsandtrolls.DungeonsAndTrolls.PickUp:output_type -> google.protobuf.Empty
-	54,  // 117: dungeonsandtrolls.DungeonsAndTrolls.Move:output_type -> google.protobuf.Empty
-	54,  // 118: dungeonsandtrolls.DungeonsAndTrolls.Respawn:output_type -> google.protobuf.Empty
-	54,  // 119: dungeonsandtrolls.DungeonsAndTrolls.Skill:output_type -> google.protobuf.Empty
-	54,  // 120: dungeonsandtrolls.DungeonsAndTrolls.Yell:output_type -> google.protobuf.Empty
-	54,  // 121: dungeonsandtrolls.DungeonsAndTrolls.Commands:output_type -> google.protobuf.Empty
-	54,  // 122: dungeonsandtrolls.DungeonsAndTrolls.MonstersCommands:output_type -> google.protobuf.Empty
-	54,  // 123: dungeonsandtrolls.DungeonsAndTrolls.AssignSkillPoints:output_type -> google.protobuf.Empty
-	110, // [110:124] is the sub-list for method output_type
-	96,  // [96:110] is the sub-list for method input_type
-	96,  // [96:96] is the sub-list for extension type_name
-	96,  // [96:96] is the sub-list for extension extendee
-	0,   // [0:96] is the sub-list for field type_name
+	61,  // 0: dungeonsandtrolls.IdentifierWithParams.identifier:type_name -> dungeonsandtrolls.Identifier
+	62,  // 1: dungeonsandtrolls.IdentifiersWithParams.identifiers:type_name -> dungeonsandtrolls.Identifiers
+	22,  // 2: dungeonsandtrolls.PositionWithParams.position:type_name -> dungeonsandtrolls.Position
+	98,  // 3: dungeonsandtrolls.RespawnWithParams.respawn:type_name -> google.protobuf.Empty
+	64,  // 4: dungeonsandtrolls.SkillUseWithParams.skill_use:type_name -> dungeonsandtrolls.SkillUse
+	20,  // 5: dungeonsandtrolls.MessageWithParams.message:type_name -> dungeonsandtrolls.Message
+	28,  // 6: dungeonsandtrolls.CommandsBatchWithParams.commands_batch:type_name -> dungeonsandtrolls.CommandsBatch
+	33,  // 7: dungeonsandtrolls.CommandsForMonstersWithParams.commands_for_monsters:type_name -> dungeonsandtrolls.CommandsForMonsters
+	35,  // 8: dungeonsandtrolls.AttributesWithParams.attributes:type_name -> dungeonsandtrolls.Attributes
+	22,  // 9: dungeonsandtrolls.Key.doors:type_name -> dungeonsandtrolls.Position
+	62,  // 10: dungeonsandtrolls.CommandsBatch.buy:type_name -> dungeonsandtrolls.Identifiers
+	61,  // 11: dungeonsandtrolls.CommandsBatch.pick_up:type_name -> dungeonsandtrolls.Identifier
+	22,  // 12: dungeonsandtrolls.CommandsBatch.move:type_name -> dungeonsandtrolls.Position
+	64,  // 13: dungeonsandtrolls.CommandsBatch.skill:type_name -> dungeonsandtrolls.SkillUse
+	20,  // 14: dungeonsandtrolls.CommandsBatch.yell:type_name -> dungeonsandtrolls.Message
+	35,  // 15: dungeonsandtrolls.CommandsBatch.assign_skill_points:type_name -> dungeonsandtrolls.Attributes
+	2,   // 16: dungeonsandtrolls.CommandsBatchStatus.status:type_name -> dungeonsandtrolls.CommandsBatchStatus.Status
+	31,  // 17: dungeonsandtrolls.CommandsBatchStatus.result:type_name -> dungeonsandtrolls.CommandResult
+	3,   // 18: dungeonsandtrolls.CommandStatus.status:type_name -> dungeonsandtrolls.CommandStatus.Status
+	0,   // 19: dungeonsandtrolls.CommandStatus.error_code:type_name -> dungeonsandtrolls.ErrorCode
+	30,  // 20: dungeonsandtrolls.CommandResult.yell:type_name -> dungeonsandtrolls.CommandStatus
+	30,  // 21: dungeonsandtrolls.CommandResult.skill:type_name -> dungeonsandtrolls.CommandStatus
+	30,  // 22: dungeonsandtrolls.CommandResult.move:type_name -> dungeonsandtrolls.CommandStatus
+	30,  // 23: dungeonsandtrolls.CommandResult.buy:type_name -> dungeonsandtrolls.CommandStatus
+	30,  // 24: dungeonsandtrolls.CommandResult.pick_up:type_name -> dungeonsandtrolls.CommandStatus
+	30,  // 25: dungeonsandtrolls.CommandResult.assign_skill_points:type_name -> dungeonsandtrolls.CommandStatus
+	58,  // 26: dungeonsandtrolls.PlayUpdate.game_state:type_name -> dungeonsandtrolls.GameState
+	29,  // 27: dungeonsandtrolls.PlayUpdate.statuses:type_name -> dungeonsandtrolls.CommandsBatchStatus
+	95,  // 28: dungeonsandtrolls.CommandsForMonsters.commands:type_name -> dungeonsandtrolls.CommandsForMonsters.CommandsEntry
+	1,   // 29: dungeonsandtrolls.Effect.damage_type:type_name -> dungeonsandtrolls.DamageType
+	35,  // 30: dungeonsandtrolls.Effect.effects:type_name -> dungeonsandtrolls.Attributes
+	35,  // 31: dungeonsandtrolls.SkillAttributes.strength:type_name -> dungeonsandtrolls.Attributes
+	35,  // 32: dungeonsandtrolls.SkillAttributes.dexterity:type_name -> dungeonsandtrolls.Attributes
+	35,  // 33: dungeonsandtrolls.SkillAttributes.intelligence:type_name -> dungeonsandtrolls.Attributes
+	35,  // 34: dungeonsandtrolls.SkillAttributes.willpower:type_name -> dungeonsandtrolls.Attributes
+	35,  // 35: dungeonsandtrolls.SkillAttributes.constitution:type_name -> dungeonsandtrolls.Attributes
+	35,  // 36: dungeonsandtrolls.SkillAttributes.slash_resist:type_name -> dungeonsandtrolls.Attributes
+	35,  // 37: dungeonsandtrolls.SkillAttributes.pierce_resist:type_name -> dungeonsandtrolls.Attributes
+	35,  // 38: dungeonsandtrolls.SkillAttributes.fire_resist:type_name -> dungeonsandtrolls.Attributes
+	35,  // 39: dungeonsandtrolls.SkillAttributes.poison_resist:type_name -> dungeonsandtrolls.Attributes
+	35,  // 40: dungeonsandtrolls.SkillAttributes.electric_resist:type_name -> dungeonsandtrolls.Attributes
+	35,  // 41: dungeonsandtrolls.SkillAttributes.life:type_name -> dungeonsandtrolls.Attributes
+	35,  // 42: dungeonsandtrolls.SkillAttributes.stamina:type_name -> dungeonsandtrolls.Attributes
+	35,  // 43: dungeonsandtrolls.SkillAttributes.mana:type_name -> dungeonsandtrolls.Attributes
+	35,  // 44: dungeonsandtrolls.SkillAttributes.constant:type_name -> dungeonsandtrolls.Attributes
+	44,  // 45: dungeonsandtrolls.Monster.items:type_name -> dungeonsandtrolls.SimpleItem
+	34,  // 46: dungeonsandtrolls.Monster.effects:type_name -> dungeonsandtrolls.Effect
+	35,  // 47: dungeonsandtrolls.Monster.attributes:type_name -> dungeonsandtrolls.Attributes
+	43,  // 48: dungeonsandtrolls.Monster.equipped_items:type_name -> dungeonsandtrolls.Item
+	45,  // 49: dungeonsandtrolls.Monster.on_death:type_name -> dungeonsandtrolls.Droppable
+	35,  // 50: dungeonsandtrolls.Monster.max_attributes:type_name -> dungeonsandtrolls.Attributes
+	38,  // 51: dungeonsandtrolls.Monster.stun:type_name -> dungeonsandtrolls.Stun
+	35,  // 52: dungeonsandtrolls.Character.attributes:type_name -> dungeonsandtrolls.Attributes
+	43,  // 53: dungeonsandtrolls.Character.equip:type_name -> dungeonsandtrolls.Item
+	34,  // 54: dungeonsandtrolls.Character.effects:type_name -> dungeonsandtrolls.Effect
+	35,  // 55: dungeonsandtrolls.Character.max_attributes:type_name -> dungeonsandtrolls.Attributes
+	63,  // 56: dungeonsandtrolls.Character.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	38,  // 57: dungeonsandtrolls.Character.stun:type_name -> dungeonsandtrolls.Stun
+	40,  // 58: dungeonsandtrolls.PlayersInfo.players:type_name -> dungeonsandtrolls.Character
+	4,   // 59: dungeonsandtrolls.Skill.target:type_name -> dungeonsandtrolls.Skill.Target
+	35,  // 60: dungeonsandtrolls.Skill.cost:type_name -> dungeonsandtrolls.Attributes
+	35,  // 61: dungeonsandtrolls.Skill.range:type_name -> dungeonsandtrolls.Attributes
+	35,  // 62: dungeonsandtrolls.Skill.radius:type_name -> dungeonsandtrolls.Attributes
+	35,  // 63: dungeonsandtrolls.Skill.duration:type_name -> dungeonsandtrolls.Attributes
+	35,  // 64: dungeonsandtrolls.Skill.damage_amount:type_name -> dungeonsandtrolls.Attributes
+	1,   // 65: dungeonsandtrolls.Skill.damage_type:type_name -> dungeonsandtrolls.DamageType
+	48,  // 66: dungeonsandtrolls.Skill.caster_effects:type_name -> dungeonsandtrolls.SkillEffect
+	48,  // 67: dungeonsandtrolls.Skill.target_effects:type_name -> dungeonsandtrolls.SkillEffect
+	46,  // 68: dungeonsandtrolls.Skill.flags:type_name -> dungeonsandtrolls.SkillGenericFlags
+	5,   // 69: dungeonsandtrolls.Item.slot:type_name -> dungeonsandtrolls.Item.Type
+	35,  // 70: dungeonsandtrolls.Item.requirements:type_name -> dungeonsandtrolls.Attributes
+	35,  // 71: dungeonsandtrolls.Item.attributes:type_name -> dungeonsandtrolls.Attributes
+	42,  // 72: dungeonsandtrolls.Item.skills:type_name -> dungeonsandtrolls.Skill
+	5,   // 73: dungeonsandtrolls.SimpleItem.slot:type_name -> dungeonsandtrolls.Item.Type
+	42,  // 74: dungeonsandtrolls.Droppable.skill:type_name -> dungeonsandtrolls.Skill
+	43,  // 75: dungeonsandtrolls.Droppable.item:type_name -> dungeonsandtrolls.Item
+	39,  // 76: dungeonsandtrolls.Droppable.monster:type_name -> dungeonsandtrolls.Monster
+	21,  // 77: dungeonsandtrolls.Droppable.decoration:type_name -> dungeonsandtrolls.Decoration
+	24,  // 78: dungeonsandtrolls.Droppable.waypoint:type_name -> dungeonsandtrolls.Waypoint
+	23,  // 79: dungeonsandtrolls.Droppable.key:type_name -> dungeonsandtrolls.Key
+	36,  // 80: dungeonsandtrolls.SkillEffect.attributes:type_name -> dungeonsandtrolls.SkillAttributes
+	47,  // 81: dungeonsandtrolls.SkillEffect.flags:type_name -> dungeonsandtrolls.SkillSpecificFlags
+	45,  // 82: dungeonsandtrolls.SkillEffect.summons:type_name -> dungeonsandtrolls.Droppable
+	63,  // 83: dungeonsandtrolls.Shortcut.leads_to:type_name -> dungeonsandtrolls.Coordinates
+	22,  // 84: dungeonsandtrolls.MapObjects.position:type_name -> dungeonsandtrolls.Position
+	39,  // 85: dungeonsandtrolls.MapObjects.monsters:type_name -> dungeonsandtrolls.Monster
+	40,  // 86: dungeonsandtrolls.MapObjects.players:type_name -> dungeonsandtrolls.Character
+	24,  // 87: dungeonsandtrolls.MapObjects.portal:type_name -> dungeonsandtrolls.Waypoint
+	21,  // 88: dungeonsandtrolls.MapObjects.decorations:type_name -> dungeonsandtrolls.Decoration
+	34,  // 89: dungeonsandtrolls.MapObjects.effects:type_name -> dungeonsandtrolls.Effect
+	43,  // 90: dungeonsandtrolls.MapObjects.items:type_name -> dungeonsandtrolls.Item
+	50,  // 91: dungeonsandtrolls.Level.objects:type_name -> dungeonsandtrolls.MapObjects
+	53,  // 92: dungeonsandtrolls.Level.fog_of_war:type_name -> dungeonsandtrolls.FogOfWarMap
+	52,  // 93: dungeonsandtrolls.Level.player_map:type_name -> dungeonsandtrolls.PlayerSpecificMap
+	22,  // 94: dungeonsandtrolls.PlayerSpecificMap.position:type_name -> dungeonsandtrolls.Position
+	22,  // 95: dungeonsandtrolls.FogOfWarMap.position:type_name -> dungeonsandtrolls.Position
+	51,  // 96: dungeonsandtrolls.Map.levels:type_name -> dungeonsandtrolls.Level
+	6,   // 97: dungeonsandtrolls.Event.type:type_name -> dungeonsandtrolls.Event.Type
+	63,  // 98: dungeonsandtrolls.Event.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	63,  // 99: dungeonsandtrolls.Event.target:type_name -> dungeonsandtrolls.Coordinates
+	42,  // 100: dungeonsandtrolls.Event.skill:type_name -> dungeonsandtrolls.Skill
+	56,  // 101: dungeonsandtrolls.Event.credits:type_name -> dungeonsandtrolls.KillCredit
+	54,  // 102: dungeonsandtrolls.GameState.map:type_name -> dungeonsandtrolls.Map
+	43,  // 103: dungeonsandtrolls.GameState.shop_items:type_name -> dungeonsandtrolls.Item
+	40,  // 104: dungeonsandtrolls.GameState.character:type_name -> dungeonsandtrolls.Character
+	22,  // 105: dungeonsandtrolls.GameState.current_position:type_name -> dungeonsandtrolls.Position
+	55,  // 106: dungeonsandtrolls.GameState.events:type_name -> dungeonsandtrolls.Event
+	59,  // 107: dungeonsandtrolls.GameState.delta:type_name -> dungeonsandtrolls.GameStateDelta
+	31,  // 108: dungeonsandtrolls.GameState.command_result:type_name -> dungeonsandtrolls.CommandResult
+	63,  // 109: dungeonsandtrolls.GameStateDelta.removed_positions:type_name -> dungeonsandtrolls.Coordinates
+	22,  // 110: dungeonsandtrolls.SkillUse.position:type_name -> dungeonsandtrolls.Position
+	66,  // 111: dungeonsandtrolls.ApiKeys.keys:type_name -> dungeonsandtrolls.ApiKeyInfo
+	63,  // 112: dungeonsandtrolls.TeleportRequest.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	43,  // 113: dungeonsandtrolls.GrantRequest.item:type_name -> dungeonsandtrolls.Item
+	40,  // 114: dungeonsandtrolls.Entity.character:type_name -> dungeonsandtrolls.Character
+	39,  // 115: dungeonsandtrolls.Entity.monster:type_name -> dungeonsandtrolls.Monster
+	43,  // 116: dungeonsandtrolls.Entity.item:type_name -> dungeonsandtrolls.Item
+	63,  // 117: dungeonsandtrolls.Entity.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	63,  // 118: dungeonsandtrolls.SpawnMonsterRequest.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	39,  // 119: dungeonsandtrolls.SpawnMonsterRequest.monster:type_name -> dungeonsandtrolls.Monster
+	63,  // 120: dungeonsandtrolls.DropItemRequest.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	43,  // 121: dungeonsandtrolls.DropItemRequest.item:type_name -> dungeonsandtrolls.Item
+	63,  // 122: dungeonsandtrolls.SetTileRequest.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	7,   // 123: dungeonsandtrolls.SetTileRequest.type:type_name -> dungeonsandtrolls.SetTileRequest.TileType
+	63,  // 124: dungeonsandtrolls.PlaceEffectRequest.coordinates:type_name -> dungeonsandtrolls.Coordinates
+	34,  // 125: dungeonsandtrolls.PlaceEffectRequest.effect:type_name -> dungeonsandtrolls.Effect
+	80,  // 126: dungeonsandtrolls.ApiKeysUsage.usage:type_name -> dungeonsandtrolls.ApiKeyUsage
+	86,  // 127: dungeonsandtrolls.WorldSnapshot.levels:type_name -> dungeonsandtrolls.LevelSnapshot
+	43,  // 128: dungeonsandtrolls.WorldSnapshot.shop_items:type_name -> dungeonsandtrolls.Item
+	87,  // 129: dungeonsandtrolls.WorldSnapshot.monsters:type_name -> dungeonsandtrolls.MonsterSnapshot
+	88,  // 130: dungeonsandtrolls.WorldSnapshot.players:type_name -> dungeonsandtrolls.PlayerSnapshot
+	96,  // 131: dungeonsandtrolls.WorldSnapshot.commands:type_name -> dungeonsandtrolls.WorldSnapshot.CommandsEntry
+	51,  // 132: dungeonsandtrolls.LevelSnapshot.level:type_name -> dungeonsandtrolls.Level
+	63,  // 133: dungeonsandtrolls.LevelSnapshot.spawn_point:type_name -> dungeonsandtrolls.Coordinates
+	63,  // 134: dungeonsandtrolls.MonsterSnapshot.position:type_name -> dungeonsandtrolls.Coordinates
+	89,  // 135: dungeonsandtrolls.MonsterSnapshot.moving_to:type_name -> dungeonsandtrolls.PathSnapshot
+	57,  // 136: dungeonsandtrolls.MonsterSnapshot.damage_ledger:type_name -> dungeonsandtrolls.DamageRecord
+	40,  // 137: dungeonsandtrolls.PlayerSnapshot.character:type_name -> dungeonsandtrolls.Character
+	35,  // 138: dungeonsandtrolls.PlayerSnapshot.base_attributes:type_name -> dungeonsandtrolls.Attributes
+	89,  // 139: dungeonsandtrolls.PlayerSnapshot.moving_to:type_name -> dungeonsandtrolls.PathSnapshot
+	57,  // 140: dungeonsandtrolls.PlayerSnapshot.damage_ledger:type_name -> dungeonsandtrolls.DamageRecord
+	22,  // 141: dungeonsandtrolls.PathSnapshot.cells:type_name -> dungeonsandtrolls.Position
+	8,   // 142: dungeonsandtrolls.LeaderboardRequest.measure:type_name -> dungeonsandtrolls.LeaderboardRequest.Measure
+	9,   // 143: dungeonsandtrolls.LeaderboardRequest.scope:type_name -> dungeonsandtrolls.LeaderboardRequest.Scope
+	97,  // 144: dungeonsandtrolls.Ranking.entries:type_name -> dungeonsandtrolls.Ranking.Entry
+	28,  // 145: dungeonsandtrolls.CommandsForMonsters.CommandsEntry.value:type_name -> dungeonsandtrolls.CommandsBatch
+	28,  // 146: dungeonsandtrolls.WorldSnapshot.CommandsEntry.value:type_name -> dungeonsandtrolls.CommandsBatch
+	25,  // 147: dungeonsandtrolls.DungeonsAndTrolls.Game:input_type -> dungeonsandtrolls.GameStateParams
+	27,  // 148: dungeonsandtrolls.DungeonsAndTrolls.GameLevel:input_type -> dungeonsandtrolls.GameStateParamsLevel
+	25,  // 149: dungeonsandtrolls.DungeonsAndTrolls.WatchGame:input_type -> dungeonsandtrolls.GameStateParams
+	19,  // 150: dungeonsandtrolls.DungeonsAndTrolls.Players:input_type -> dungeonsandtrolls.PlayersParams
+	19,  // 151: dungeonsandtrolls.DungeonsAndTrolls.Levels:input_type -> dungeonsandtrolls.PlayersParams
+	60,  // 152: dungeonsandtrolls.DungeonsAndTrolls.Register:input_type -> dungeonsandtrolls.User
+	11,  // 153: dungeonsandtrolls.DungeonsAndTrolls.Buy:input_type -> dungeonsandtrolls.IdentifiersWithParams
+	10,  // 154: dungeonsandtrolls.DungeonsAndTrolls.PickUp:input_type -> dungeonsandtrolls.IdentifierWithParams
+	12,  // 155: dungeonsandtrolls.DungeonsAndTrolls.Move:input_type -> dungeonsandtrolls.PositionWithParams
+	13,  // 156: dungeonsandtrolls.DungeonsAndTrolls.Respawn:input_type -> dungeonsandtrolls.RespawnWithParams
+	14,  // 157: dungeonsandtrolls.DungeonsAndTrolls.Skill:input_type -> dungeonsandtrolls.SkillUseWithParams
+	15,  // 158: dungeonsandtrolls.DungeonsAndTrolls.Yell:input_type -> dungeonsandtrolls.MessageWithParams
+	16,  // 159: dungeonsandtrolls.DungeonsAndTrolls.Commands:input_type -> dungeonsandtrolls.CommandsBatchWithParams
+	28,  // 160: dungeonsandtrolls.DungeonsAndTrolls.Play:input_type -> dungeonsandtrolls.CommandsBatch
+	17,  // 161: dungeonsandtrolls.DungeonsAndTrolls.MonstersCommands:input_type -> dungeonsandtrolls.CommandsForMonstersWithParams
+	18,  // 162: dungeonsandtrolls.DungeonsAndTrolls.AssignSkillPoints:input_type -> dungeonsandtrolls.AttributesWithParams
+	98,  // 163: dungeonsandtrolls.DungeonsAndTrolls.RotateApiKey:input_type -> google.protobuf.Empty
+	68,  // 164: dungeonsandtrolls.DungeonsAndTrolls.ListApiKeys:input_type -> dungeonsandtrolls.ApiKeysRequest
+	61,  // 165: dungeonsandtrolls.DungeonsAndTrolls.RevokeApiKey:input_type -> dungeonsandtrolls.Identifier
+	69,  // 166: dungeonsandtrolls.DungeonsAndTrolls.IssueApiKey:input_type -> dungeonsandtrolls.ApiKeyRequest
+	90,  // 167: dungeonsandtrolls.DungeonsAndTrolls.Profile:input_type -> dungeonsandtrolls.ProfileRequest
+	92,  // 168: dungeonsandtrolls.DungeonsAndTrolls.Leaderboard:input_type -> dungeonsandtrolls.LeaderboardRequest
+	70,  // 169: dungeonsandtrolls.DungeonsAndTrollsAdmin.KickPlayer:input_type -> dungeonsandtrolls.KickPlayerRequest
+	61,  // 170: dungeonsandtrolls.DungeonsAndTrollsAdmin.RespawnPlayer:input_type -> dungeonsandtrolls.Identifier
+	71,  // 171: dungeonsandtrolls.DungeonsAndTrollsAdmin.TeleportPlayer:input_type -> dungeonsandtrolls.TeleportRequest
+	72,  // 172: dungeonsandtrolls.DungeonsAndTrollsAdmin.RegenerateLevel:input_type -> dungeonsandtrolls.LevelRequest
+	73,  // 173: dungeonsandtrolls.DungeonsAndTrollsAdmin.Grant:input_type -> dungeonsandtrolls.GrantRequest
+	74,  // 174: dungeonsandtrolls.DungeonsAndTrollsAdmin.SetGameProgress:input_type -> dungeonsandtrolls.GameProgress
+	61,  // 175: dungeonsandtrolls.DungeonsAndTrollsAdmin.GetEntity:input_type -> dungeonsandtrolls.Identifier
+	76,  // 176: dungeonsandtrolls.DungeonsAndTrollsAdmin.SpawnMonster:input_type -> dungeonsandtrolls.SpawnMonsterRequest
+	77,  // 177: dungeonsandtrolls.DungeonsAndTrollsAdmin.DropItem:input_type -> dungeonsandtrolls.DropItemRequest
+	78,  // 178: dungeonsandtrolls.DungeonsAndTrollsAdmin.SetTile:input_type -> dungeonsandtrolls.SetTileRequest
+	79,  // 179: dungeonsandtrolls.DungeonsAndTrollsAdmin.PlaceEffect:input_type -> dungeonsandtrolls.PlaceEffectRequest
+	98,  // 180: dungeonsandtrolls.DungeonsAndTrollsAdmin.GetApiKeysUsage:input_type -> google.protobuf.Empty
+	98,  // 181: dungeonsandtrolls.DungeonsAndTrollsAdmin.GetBalance:input_type -> google.protobuf.Empty
+	83,  // 182: dungeonsandtrolls.DungeonsAndTrollsAdmin.Step:input_type -> dungeonsandtrolls.StepRequest
+	58,  // 183: dungeonsandtrolls.DungeonsAndTrolls.Game:output_type -> dungeonsandtrolls.GameState
+	58,  // 184: dungeonsandtrolls.DungeonsAndTrolls.GameLevel:output_type -> dungeonsandtrolls.GameState
+	58,  // 185: dungeonsandtrolls.DungeonsAndTrolls.WatchGame:output_type -> dungeonsandtrolls.GameState
+	41,  // 186: dungeonsandtrolls.DungeonsAndTrolls.Players:output_type -> dungeonsandtrolls.PlayersInfo
+	26,  // 187: dungeonsandtrolls.DungeonsAndTrolls.Levels:output_type -> dungeonsandtrolls.AvailableLevels
+	65,  // 188: dungeonsandtrolls.DungeonsAndTrolls.Register:output_type -> dungeonsandtrolls.Registration
+	31,  // 189: dungeonsandtrolls.DungeonsAndTrolls.Buy:output_type -> dungeonsandtrolls.CommandResult
+	31,  // 190: dungeonsandtrolls.DungeonsAndTrolls.PickUp:output_type -> dungeonsandtrolls.CommandResult
+	31,  // 191: dungeonsandtrolls.DungeonsAndTrolls.Move:output_type -> dungeonsandtrolls.CommandResult
+	98,  // 192: dungeonsandtrolls.DungeonsAndTrolls.Respawn:output_type -> google.protobuf.Empty
+	31,  // 193: dungeonsandtrolls.DungeonsAndTrolls.Skill:output_type -> dungeonsandtrolls.CommandResult
+	31,  // 194: dungeonsandtrolls.DungeonsAndTrolls.Yell:output_type -> dungeonsandtrolls.CommandResult
+	31,  // 195: dungeonsandtrolls.DungeonsAndTrolls.Commands:output_type -> dungeonsandtrolls.CommandResult
+	32,  // 196: dungeonsandtrolls.DungeonsAndTrolls.Play:output_type -> dungeonsandtrolls.PlayUpdate
+	98,  // 197: dungeonsandtrolls.DungeonsAndTrolls.MonstersCommands:output_type -> google.protobuf.Empty
+	31,  // 198: dungeonsandtrolls.DungeonsAndTrolls.AssignSkillPoints:output_type -> dungeonsandtrolls.CommandResult
+	65,  // 199: dungeonsandtrolls.DungeonsAndTrolls.RotateApiKey:output_type -> dungeonsandtrolls.Registration
+	67,  // 200: dungeonsandtrolls.DungeonsAndTrolls.ListApiKeys:output_type -> dungeonsandtrolls.ApiKeys
+	98,  // 201: dungeonsandtrolls.DungeonsAndTrolls.RevokeApiKey:output_type -> google.protobuf.Empty
+	65,  // 202: dungeonsandtrolls.DungeonsAndTrolls.IssueApiKey:output_type -> dungeonsandtrolls.Registration
+	91,  // 203: dungeonsandtrolls.DungeonsAndTrolls.Profile:output_type -> dungeonsandtrolls.PlayerProfile
+	94,  // 204: dungeonsandtrolls.DungeonsAndTrolls.Leaderboard:output_type -> dungeonsandtrolls.Ranking
+	98,  // 205: dungeonsandtrolls.DungeonsAndTrollsAdmin.KickPlayer:output_type -> google.protobuf.Empty
+	98,  // 206: dungeonsandtrolls.DungeonsAndTrollsAdmin.RespawnPlayer:output_type -> google.protobuf.Empty
+	98,  // 207: dungeonsandtrolls.DungeonsAndTrollsAdmin.TeleportPlayer:output_type -> google.protobuf.Empty
+	98,  // 208: dungeonsandtrolls.DungeonsAndTrollsAdmin.RegenerateLevel:output_type -> google.protobuf.Empty
+	98,  // 209: dungeonsandtrolls.DungeonsAndTrollsAdmin.Grant:output_type -> google.protobuf.Empty
+	98,  // 210: dungeonsandtrolls.DungeonsAndTrollsAdmin.SetGameProgress:output_type -> google.protobuf.Empty
+	75,  // 211: dungeonsandtrolls.DungeonsAndTrollsAdmin.GetEntity:output_type -> dungeonsandtrolls.Entity
+	61,  // 212: dungeonsandtrolls.DungeonsAndTrollsAdmin.SpawnMonster:output_type -> dungeonsandtrolls.Identifier
+	61,  // 213: dungeonsandtrolls.DungeonsAndTrollsAdmin.DropItem:output_type -> dungeonsandtrolls.Identifier
+	98,  // 214: dungeonsandtrolls.DungeonsAndTrollsAdmin.SetTile:output_type -> google.protobuf.Empty
+	98,  // 215: dungeonsandtrolls.DungeonsAndTrollsAdmin.PlaceEffect:output_type -> google.protobuf.Empty
+	81,  // 216: dungeonsandtrolls.DungeonsAndTrollsAdmin.GetApiKeysUsage:output_type -> dungeonsandtrolls.ApiKeysUsage
+	82,  // 217: dungeonsandtrolls.DungeonsAndTrollsAdmin.GetBalance:output_type -> dungeonsandtrolls.Balance
+	84,  // 218: dungeonsandtrolls.DungeonsAndTrollsAdmin.Step:output_type -> dungeonsandtrolls.StepResult
+	183, // [183:219] is the sub-list for method output_type
+	147, // [147:183] is the sub-list for method input_type
+	147, // [147:147] is the sub-list for extension type_name
+	147, // [147:147] is the sub-list for extension extendee
+	0,   // [0:147] is the sub-list for field type_name
 }
 
 func init() { file_proto_dungeonsandtrolls_proto_init() }
@@ -4863,8 +8604,320 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IdentifiersWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentifiersWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PositionWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespawnWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkillUseWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandsBatchWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandsForMonstersWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributesWithParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayersParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decoration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Waypoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameStateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailableLevels); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameStateParamsLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandsBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandsBatchStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandsForMonsters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Effect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkillAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4875,8 +8928,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PositionWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stun); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4887,8 +8940,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespawnWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Monster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4899,8 +8952,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkillUseWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Character); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4911,8 +8964,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayersInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4923,8 +8976,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandsBatchWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Skill); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4935,8 +8988,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandsForMonstersWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4947,8 +9000,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttributesWithParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4959,8 +9012,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayersParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Droppable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4971,8 +9024,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkillGenericFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4983,8 +9036,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Decoration); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkillSpecificFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4995,8 +9048,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Position); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkillEffect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5007,8 +9060,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Key); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shortcut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5019,8 +9072,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Waypoint); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapObjects); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5031,8 +9084,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameStateParams); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Level); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5043,8 +9096,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AvailableLevels); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerSpecificMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5055,8 +9108,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameStateParamsLevel); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FogOfWarMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5067,8 +9120,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandsBatch); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Map); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5079,8 +9132,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandsForMonsters); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5091,8 +9144,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Effect); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillCredit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5103,8 +9156,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attributes); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DamageRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5115,8 +9168,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkillAttributes); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5127,8 +9180,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameStateDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5139,8 +9192,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stun); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5151,8 +9204,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Monster); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Identifier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5163,8 +9216,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Character); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Identifiers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5175,8 +9228,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayersInfo); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coordinates); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5187,8 +9240,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Skill); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkillUse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5199,8 +9252,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Item); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Registration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5211,8 +9264,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleItem); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKeyInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5223,8 +9276,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Droppable); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKeys); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5235,8 +9288,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkillGenericFlags); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5247,8 +9300,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkillSpecificFlags); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5259,8 +9312,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkillEffect); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5271,8 +9324,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Shortcut); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeleportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5283,8 +9336,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapObjects); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5295,8 +9348,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Level); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5307,8 +9360,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerSpecificMap); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5319,8 +9372,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FogOfWarMap); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5331,8 +9384,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Map); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpawnMonsterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5343,8 +9396,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropItemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5355,8 +9408,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameState); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5367,8 +9420,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceEffectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5379,8 +9432,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Identifier); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKeyUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5391,8 +9444,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Identifiers); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKeysUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5403,8 +9456,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Coordinates); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Balance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5415,8 +9468,8 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkillUse); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5427,8 +9480,140 @@ func file_proto_dungeonsandtrolls_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dungeonsandtrolls_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Registration); i {
+		file_proto_dungeonsandtrolls_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorldSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LevelSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonsterSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ranking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dungeonsandtrolls_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ranking_Entry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5453,12 +9638,16 @@ func file_proto_dungeonsandtrolls_proto_init() {
 	file_proto_dungeonsandtrolls_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[17].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[20].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[21].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[24].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[26].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[29].OneofWrappers = []interface{}{}
-	file_proto_dungeonsandtrolls_proto_msgTypes[31].OneofWrappers = []interface{}{
+	file_proto_dungeonsandtrolls_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*Droppable_Skill)(nil),
 		(*Droppable_Item)(nil),
 		(*Droppable_Monster)(nil),
@@ -5466,21 +9655,38 @@ func file_proto_dungeonsandtrolls_proto_init() {
 		(*Droppable_Waypoint)(nil),
 		(*Droppable_Key)(nil),
 	}
-	file_proto_dungeonsandtrolls_proto_msgTypes[35].OneofWrappers = []interface{}{}
-	file_proto_dungeonsandtrolls_proto_msgTypes[36].OneofWrappers = []interface{}{}
-	file_proto_dungeonsandtrolls_proto_msgTypes[41].OneofWrappers = []interface{}{}
-	file_proto_dungeonsandtrolls_proto_msgTypes[42].OneofWrappers = []interface{}{}
-	file_proto_dungeonsandtrolls_proto_msgTypes[47].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[45].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[46].OneofWrappers = []interface{}{}
 	file_proto_dungeonsandtrolls_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[55].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[59].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[65].OneofWrappers = []interface{}{
+		(*Entity_Character)(nil),
+		(*Entity_Monster)(nil),
+		(*Entity_Item)(nil),
+	}
+	file_proto_dungeonsandtrolls_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[72].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[77].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[80].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[82].OneofWrappers = []interface{}{}
+	file_proto_dungeonsandtrolls_proto_msgTypes[84].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_dungeonsandtrolls_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   50,
+			NumEnums:      10,
+			NumMessages:   88,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_dungeonsandtrolls_proto_goTypes,
 		DependencyIndexes: file_proto_dungeonsandtrolls_proto_depIdxs,
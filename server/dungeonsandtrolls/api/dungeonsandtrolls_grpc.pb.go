@@ -25,18 +25,26 @@ const _ = grpc.SupportPackageIsVersion7
 type DungeonsAndTrollsClient interface {
 	Game(ctx context.Context, in *GameStateParams, opts ...grpc.CallOption) (*GameState, error)
 	GameLevel(ctx context.Context, in *GameStateParamsLevel, opts ...grpc.CallOption) (*GameState, error)
+	WatchGame(ctx context.Context, in *GameStateParams, opts ...grpc.CallOption) (DungeonsAndTrolls_WatchGameClient, error)
 	Players(ctx context.Context, in *PlayersParams, opts ...grpc.CallOption) (*PlayersInfo, error)
 	Levels(ctx context.Context, in *PlayersParams, opts ...grpc.CallOption) (*AvailableLevels, error)
 	Register(ctx context.Context, in *User, opts ...grpc.CallOption) (*Registration, error)
-	Buy(ctx context.Context, in *IdentifiersWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	PickUp(ctx context.Context, in *IdentifierWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Move(ctx context.Context, in *PositionWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Buy(ctx context.Context, in *IdentifiersWithParams, opts ...grpc.CallOption) (*CommandResult, error)
+	PickUp(ctx context.Context, in *IdentifierWithParams, opts ...grpc.CallOption) (*CommandResult, error)
+	Move(ctx context.Context, in *PositionWithParams, opts ...grpc.CallOption) (*CommandResult, error)
 	Respawn(ctx context.Context, in *RespawnWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Skill(ctx context.Context, in *SkillUseWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Yell(ctx context.Context, in *MessageWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Commands(ctx context.Context, in *CommandsBatchWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Skill(ctx context.Context, in *SkillUseWithParams, opts ...grpc.CallOption) (*CommandResult, error)
+	Yell(ctx context.Context, in *MessageWithParams, opts ...grpc.CallOption) (*CommandResult, error)
+	Commands(ctx context.Context, in *CommandsBatchWithParams, opts ...grpc.CallOption) (*CommandResult, error)
+	Play(ctx context.Context, opts ...grpc.CallOption) (DungeonsAndTrolls_PlayClient, error)
 	MonstersCommands(ctx context.Context, in *CommandsForMonstersWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	AssignSkillPoints(ctx context.Context, in *AttributesWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AssignSkillPoints(ctx context.Context, in *AttributesWithParams, opts ...grpc.CallOption) (*CommandResult, error)
+	RotateApiKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Registration, error)
+	ListApiKeys(ctx context.Context, in *ApiKeysRequest, opts ...grpc.CallOption) (*ApiKeys, error)
+	RevokeApiKey(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IssueApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*Registration, error)
+	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*PlayerProfile, error)
+	Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Ranking, error)
 }
 
 type dungeonsAndTrollsClient struct {
@@ -65,6 +73,38 @@ func (c *dungeonsAndTrollsClient) GameLevel(ctx context.Context, in *GameStatePa
 	return out, nil
 }
 
+func (c *dungeonsAndTrollsClient) WatchGame(ctx context.Context, in *GameStateParams, opts ...grpc.CallOption) (DungeonsAndTrolls_WatchGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DungeonsAndTrolls_ServiceDesc.Streams[0], "/dungeonsandtrolls.DungeonsAndTrolls/WatchGame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dungeonsAndTrollsWatchGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DungeonsAndTrolls_WatchGameClient interface {
+	Recv() (*GameState, error)
+	grpc.ClientStream
+}
+
+type dungeonsAndTrollsWatchGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *dungeonsAndTrollsWatchGameClient) Recv() (*GameState, error) {
+	m := new(GameState)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dungeonsAndTrollsClient) Players(ctx context.Context, in *PlayersParams, opts ...grpc.CallOption) (*PlayersInfo, error) {
 	out := new(PlayersInfo)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Players", in, out, opts...)
@@ -92,8 +132,8 @@ func (c *dungeonsAndTrollsClient) Register(ctx context.Context, in *User, opts .
 	return out, nil
 }
 
-func (c *dungeonsAndTrollsClient) Buy(ctx context.Context, in *IdentifiersWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *dungeonsAndTrollsClient) Buy(ctx context.Context, in *IdentifiersWithParams, opts ...grpc.CallOption) (*CommandResult, error) {
+	out := new(CommandResult)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Buy", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -101,8 +141,8 @@ func (c *dungeonsAndTrollsClient) Buy(ctx context.Context, in *IdentifiersWithPa
 	return out, nil
 }
 
-func (c *dungeonsAndTrollsClient) PickUp(ctx context.Context, in *IdentifierWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *dungeonsAndTrollsClient) PickUp(ctx context.Context, in *IdentifierWithParams, opts ...grpc.CallOption) (*CommandResult, error) {
+	out := new(CommandResult)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/PickUp", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -110,8 +150,8 @@ func (c *dungeonsAndTrollsClient) PickUp(ctx context.Context, in *IdentifierWith
 	return out, nil
 }
 
-func (c *dungeonsAndTrollsClient) Move(ctx context.Context, in *PositionWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *dungeonsAndTrollsClient) Move(ctx context.Context, in *PositionWithParams, opts ...grpc.CallOption) (*CommandResult, error) {
+	out := new(CommandResult)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Move", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -128,8 +168,8 @@ func (c *dungeonsAndTrollsClient) Respawn(ctx context.Context, in *RespawnWithPa
 	return out, nil
 }
 
-func (c *dungeonsAndTrollsClient) Skill(ctx context.Context, in *SkillUseWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *dungeonsAndTrollsClient) Skill(ctx context.Context, in *SkillUseWithParams, opts ...grpc.CallOption) (*CommandResult, error) {
+	out := new(CommandResult)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Skill", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -137,8 +177,8 @@ func (c *dungeonsAndTrollsClient) Skill(ctx context.Context, in *SkillUseWithPar
 	return out, nil
 }
 
-func (c *dungeonsAndTrollsClient) Yell(ctx context.Context, in *MessageWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *dungeonsAndTrollsClient) Yell(ctx context.Context, in *MessageWithParams, opts ...grpc.CallOption) (*CommandResult, error) {
+	out := new(CommandResult)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Yell", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -146,8 +186,8 @@ func (c *dungeonsAndTrollsClient) Yell(ctx context.Context, in *MessageWithParam
 	return out, nil
 }
 
-func (c *dungeonsAndTrollsClient) Commands(ctx context.Context, in *CommandsBatchWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *dungeonsAndTrollsClient) Commands(ctx context.Context, in *CommandsBatchWithParams, opts ...grpc.CallOption) (*CommandResult, error) {
+	out := new(CommandResult)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Commands", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -155,6 +195,37 @@ func (c *dungeonsAndTrollsClient) Commands(ctx context.Context, in *CommandsBatc
 	return out, nil
 }
 
+func (c *dungeonsAndTrollsClient) Play(ctx context.Context, opts ...grpc.CallOption) (DungeonsAndTrolls_PlayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DungeonsAndTrolls_ServiceDesc.Streams[1], "/dungeonsandtrolls.DungeonsAndTrolls/Play", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dungeonsAndTrollsPlayClient{stream}
+	return x, nil
+}
+
+type DungeonsAndTrolls_PlayClient interface {
+	Send(*CommandsBatch) error
+	Recv() (*PlayUpdate, error)
+	grpc.ClientStream
+}
+
+type dungeonsAndTrollsPlayClient struct {
+	grpc.ClientStream
+}
+
+func (x *dungeonsAndTrollsPlayClient) Send(m *CommandsBatch) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dungeonsAndTrollsPlayClient) Recv() (*PlayUpdate, error) {
+	m := new(PlayUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dungeonsAndTrollsClient) MonstersCommands(ctx context.Context, in *CommandsForMonstersWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/MonstersCommands", in, out, opts...)
@@ -164,8 +235,8 @@ func (c *dungeonsAndTrollsClient) MonstersCommands(ctx context.Context, in *Comm
 	return out, nil
 }
 
-func (c *dungeonsAndTrollsClient) AssignSkillPoints(ctx context.Context, in *AttributesWithParams, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *dungeonsAndTrollsClient) AssignSkillPoints(ctx context.Context, in *AttributesWithParams, opts ...grpc.CallOption) (*CommandResult, error) {
+	out := new(CommandResult)
 	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/AssignSkillPoints", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -173,24 +244,86 @@ func (c *dungeonsAndTrollsClient) AssignSkillPoints(ctx context.Context, in *Att
 	return out, nil
 }
 
+func (c *dungeonsAndTrollsClient) RotateApiKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Registration, error) {
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/RotateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsClient) ListApiKeys(ctx context.Context, in *ApiKeysRequest, opts ...grpc.CallOption) (*ApiKeys, error) {
+	out := new(ApiKeys)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsClient) RevokeApiKey(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsClient) IssueApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*Registration, error) {
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/IssueApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsClient) Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*PlayerProfile, error) {
+	out := new(PlayerProfile)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Profile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsClient) Leaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Ranking, error) {
+	out := new(Ranking)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrolls/Leaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DungeonsAndTrollsServer is the server API for DungeonsAndTrolls service.
 // All implementations must embed UnimplementedDungeonsAndTrollsServer
 // for forward compatibility
 type DungeonsAndTrollsServer interface {
 	Game(context.Context, *GameStateParams) (*GameState, error)
 	GameLevel(context.Context, *GameStateParamsLevel) (*GameState, error)
+	WatchGame(*GameStateParams, DungeonsAndTrolls_WatchGameServer) error
 	Players(context.Context, *PlayersParams) (*PlayersInfo, error)
 	Levels(context.Context, *PlayersParams) (*AvailableLevels, error)
 	Register(context.Context, *User) (*Registration, error)
-	Buy(context.Context, *IdentifiersWithParams) (*emptypb.Empty, error)
-	PickUp(context.Context, *IdentifierWithParams) (*emptypb.Empty, error)
-	Move(context.Context, *PositionWithParams) (*emptypb.Empty, error)
+	Buy(context.Context, *IdentifiersWithParams) (*CommandResult, error)
+	PickUp(context.Context, *IdentifierWithParams) (*CommandResult, error)
+	Move(context.Context, *PositionWithParams) (*CommandResult, error)
 	Respawn(context.Context, *RespawnWithParams) (*emptypb.Empty, error)
-	Skill(context.Context, *SkillUseWithParams) (*emptypb.Empty, error)
-	Yell(context.Context, *MessageWithParams) (*emptypb.Empty, error)
-	Commands(context.Context, *CommandsBatchWithParams) (*emptypb.Empty, error)
+	Skill(context.Context, *SkillUseWithParams) (*CommandResult, error)
+	Yell(context.Context, *MessageWithParams) (*CommandResult, error)
+	Commands(context.Context, *CommandsBatchWithParams) (*CommandResult, error)
+	Play(DungeonsAndTrolls_PlayServer) error
 	MonstersCommands(context.Context, *CommandsForMonstersWithParams) (*emptypb.Empty, error)
-	AssignSkillPoints(context.Context, *AttributesWithParams) (*emptypb.Empty, error)
+	AssignSkillPoints(context.Context, *AttributesWithParams) (*CommandResult, error)
+	RotateApiKey(context.Context, *emptypb.Empty) (*Registration, error)
+	ListApiKeys(context.Context, *ApiKeysRequest) (*ApiKeys, error)
+	RevokeApiKey(context.Context, *Identifier) (*emptypb.Empty, error)
+	IssueApiKey(context.Context, *ApiKeyRequest) (*Registration, error)
+	Profile(context.Context, *ProfileRequest) (*PlayerProfile, error)
+	Leaderboard(context.Context, *LeaderboardRequest) (*Ranking, error)
 	mustEmbedUnimplementedDungeonsAndTrollsServer()
 }
 
@@ -204,6 +337,9 @@ func (UnimplementedDungeonsAndTrollsServer) Game(context.Context, *GameStatePara
 func (UnimplementedDungeonsAndTrollsServer) GameLevel(context.Context, *GameStateParamsLevel) (*GameState, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GameLevel not implemented")
 }
+func (UnimplementedDungeonsAndTrollsServer) WatchGame(*GameStateParams, DungeonsAndTrolls_WatchGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
 func (UnimplementedDungeonsAndTrollsServer) Players(context.Context, *PlayersParams) (*PlayersInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Players not implemented")
 }
@@ -213,33 +349,54 @@ func (UnimplementedDungeonsAndTrollsServer) Levels(context.Context, *PlayersPara
 func (UnimplementedDungeonsAndTrollsServer) Register(context.Context, *User) (*Registration, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
-func (UnimplementedDungeonsAndTrollsServer) Buy(context.Context, *IdentifiersWithParams) (*emptypb.Empty, error) {
+func (UnimplementedDungeonsAndTrollsServer) Buy(context.Context, *IdentifiersWithParams) (*CommandResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Buy not implemented")
 }
-func (UnimplementedDungeonsAndTrollsServer) PickUp(context.Context, *IdentifierWithParams) (*emptypb.Empty, error) {
+func (UnimplementedDungeonsAndTrollsServer) PickUp(context.Context, *IdentifierWithParams) (*CommandResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PickUp not implemented")
 }
-func (UnimplementedDungeonsAndTrollsServer) Move(context.Context, *PositionWithParams) (*emptypb.Empty, error) {
+func (UnimplementedDungeonsAndTrollsServer) Move(context.Context, *PositionWithParams) (*CommandResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
 }
 func (UnimplementedDungeonsAndTrollsServer) Respawn(context.Context, *RespawnWithParams) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Respawn not implemented")
 }
-func (UnimplementedDungeonsAndTrollsServer) Skill(context.Context, *SkillUseWithParams) (*emptypb.Empty, error) {
+func (UnimplementedDungeonsAndTrollsServer) Skill(context.Context, *SkillUseWithParams) (*CommandResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Skill not implemented")
 }
-func (UnimplementedDungeonsAndTrollsServer) Yell(context.Context, *MessageWithParams) (*emptypb.Empty, error) {
+func (UnimplementedDungeonsAndTrollsServer) Yell(context.Context, *MessageWithParams) (*CommandResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Yell not implemented")
 }
-func (UnimplementedDungeonsAndTrollsServer) Commands(context.Context, *CommandsBatchWithParams) (*emptypb.Empty, error) {
+func (UnimplementedDungeonsAndTrollsServer) Commands(context.Context, *CommandsBatchWithParams) (*CommandResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Commands not implemented")
 }
+func (UnimplementedDungeonsAndTrollsServer) Play(DungeonsAndTrolls_PlayServer) error {
+	return status.Errorf(codes.Unimplemented, "method Play not implemented")
+}
 func (UnimplementedDungeonsAndTrollsServer) MonstersCommands(context.Context, *CommandsForMonstersWithParams) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MonstersCommands not implemented")
 }
-func (UnimplementedDungeonsAndTrollsServer) AssignSkillPoints(context.Context, *AttributesWithParams) (*emptypb.Empty, error) {
+func (UnimplementedDungeonsAndTrollsServer) AssignSkillPoints(context.Context, *AttributesWithParams) (*CommandResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssignSkillPoints not implemented")
 }
+func (UnimplementedDungeonsAndTrollsServer) RotateApiKey(context.Context, *emptypb.Empty) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedDungeonsAndTrollsServer) ListApiKeys(context.Context, *ApiKeysRequest) (*ApiKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedDungeonsAndTrollsServer) RevokeApiKey(context.Context, *Identifier) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedDungeonsAndTrollsServer) IssueApiKey(context.Context, *ApiKeyRequest) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueApiKey not implemented")
+}
+func (UnimplementedDungeonsAndTrollsServer) Profile(context.Context, *ProfileRequest) (*PlayerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+func (UnimplementedDungeonsAndTrollsServer) Leaderboard(context.Context, *LeaderboardRequest) (*Ranking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leaderboard not implemented")
+}
 func (UnimplementedDungeonsAndTrollsServer) mustEmbedUnimplementedDungeonsAndTrollsServer() {}
 
 // UnsafeDungeonsAndTrollsServer may be embedded to opt out of forward compatibility for this service.
@@ -289,6 +446,27 @@ func _DungeonsAndTrolls_GameLevel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DungeonsAndTrolls_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GameStateParams)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DungeonsAndTrollsServer).WatchGame(m, &dungeonsAndTrollsWatchGameServer{stream})
+}
+
+type DungeonsAndTrolls_WatchGameServer interface {
+	Send(*GameState) error
+	grpc.ServerStream
+}
+
+type dungeonsAndTrollsWatchGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *dungeonsAndTrollsWatchGameServer) Send(m *GameState) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DungeonsAndTrolls_Players_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PlayersParams)
 	if err := dec(in); err != nil {
@@ -469,6 +647,32 @@ func _DungeonsAndTrolls_Commands_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DungeonsAndTrolls_Play_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DungeonsAndTrollsServer).Play(&dungeonsAndTrollsPlayServer{stream})
+}
+
+type DungeonsAndTrolls_PlayServer interface {
+	Send(*PlayUpdate) error
+	Recv() (*CommandsBatch, error)
+	grpc.ServerStream
+}
+
+type dungeonsAndTrollsPlayServer struct {
+	grpc.ServerStream
+}
+
+func (x *dungeonsAndTrollsPlayServer) Send(m *PlayUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dungeonsAndTrollsPlayServer) Recv() (*CommandsBatch, error) {
+	m := new(CommandsBatch)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _DungeonsAndTrolls_MonstersCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommandsForMonstersWithParams)
 	if err := dec(in); err != nil {
@@ -505,6 +709,114 @@ func _DungeonsAndTrolls_AssignSkillPoints_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DungeonsAndTrolls_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrolls/RotateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsServer).RotateApiKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrolls_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrolls/ListApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsServer).ListApiKeys(ctx, req.(*ApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrolls_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrolls/RevokeApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsServer).RevokeApiKey(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrolls_IssueApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsServer).IssueApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrolls/IssueApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsServer).IssueApiKey(ctx, req.(*ApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrolls_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrolls/Profile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsServer).Profile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrolls_Leaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsServer).Leaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrolls/Leaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsServer).Leaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DungeonsAndTrolls_ServiceDesc is the grpc.ServiceDesc for DungeonsAndTrolls service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -568,6 +880,597 @@ var DungeonsAndTrolls_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssignSkillPoints",
 			Handler:    _DungeonsAndTrolls_AssignSkillPoints_Handler,
 		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _DungeonsAndTrolls_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _DungeonsAndTrolls_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _DungeonsAndTrolls_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "IssueApiKey",
+			Handler:    _DungeonsAndTrolls_IssueApiKey_Handler,
+		},
+		{
+			MethodName: "Profile",
+			Handler:    _DungeonsAndTrolls_Profile_Handler,
+		},
+		{
+			MethodName: "Leaderboard",
+			Handler:    _DungeonsAndTrolls_Leaderboard_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _DungeonsAndTrolls_WatchGame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Play",
+			Handler:       _DungeonsAndTrolls_Play_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/dungeonsandtrolls.proto",
+}
+
+// DungeonsAndTrollsAdminClient is the client API for DungeonsAndTrollsAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DungeonsAndTrollsAdminClient interface {
+	KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RespawnPlayer(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TeleportPlayer(ctx context.Context, in *TeleportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegenerateLevel(ctx context.Context, in *LevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Grant(ctx context.Context, in *GrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetGameProgress(ctx context.Context, in *GameProgress, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetEntity(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Entity, error)
+	SpawnMonster(ctx context.Context, in *SpawnMonsterRequest, opts ...grpc.CallOption) (*Identifier, error)
+	DropItem(ctx context.Context, in *DropItemRequest, opts ...grpc.CallOption) (*Identifier, error)
+	SetTile(ctx context.Context, in *SetTileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PlaceEffect(ctx context.Context, in *PlaceEffectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetApiKeysUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiKeysUsage, error)
+	GetBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Balance, error)
+	Step(ctx context.Context, in *StepRequest, opts ...grpc.CallOption) (*StepResult, error)
+}
+
+type dungeonsAndTrollsAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDungeonsAndTrollsAdminClient(cc grpc.ClientConnInterface) DungeonsAndTrollsAdminClient {
+	return &dungeonsAndTrollsAdminClient{cc}
+}
+
+func (c *dungeonsAndTrollsAdminClient) KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/KickPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) RespawnPlayer(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/RespawnPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) TeleportPlayer(ctx context.Context, in *TeleportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/TeleportPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) RegenerateLevel(ctx context.Context, in *LevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/RegenerateLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) Grant(ctx context.Context, in *GrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/Grant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) SetGameProgress(ctx context.Context, in *GameProgress, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/SetGameProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) GetEntity(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Entity, error) {
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/GetEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) SpawnMonster(ctx context.Context, in *SpawnMonsterRequest, opts ...grpc.CallOption) (*Identifier, error) {
+	out := new(Identifier)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/SpawnMonster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) DropItem(ctx context.Context, in *DropItemRequest, opts ...grpc.CallOption) (*Identifier, error) {
+	out := new(Identifier)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/DropItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) SetTile(ctx context.Context, in *SetTileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/SetTile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) PlaceEffect(ctx context.Context, in *PlaceEffectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/PlaceEffect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) GetApiKeysUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiKeysUsage, error) {
+	out := new(ApiKeysUsage)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/GetApiKeysUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) GetBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dungeonsAndTrollsAdminClient) Step(ctx context.Context, in *StepRequest, opts ...grpc.CallOption) (*StepResult, error) {
+	out := new(StepResult)
+	err := c.cc.Invoke(ctx, "/dungeonsandtrolls.DungeonsAndTrollsAdmin/Step", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DungeonsAndTrollsAdminServer is the server API for DungeonsAndTrollsAdmin service.
+// All implementations must embed UnimplementedDungeonsAndTrollsAdminServer
+// for forward compatibility
+type DungeonsAndTrollsAdminServer interface {
+	KickPlayer(context.Context, *KickPlayerRequest) (*emptypb.Empty, error)
+	RespawnPlayer(context.Context, *Identifier) (*emptypb.Empty, error)
+	TeleportPlayer(context.Context, *TeleportRequest) (*emptypb.Empty, error)
+	RegenerateLevel(context.Context, *LevelRequest) (*emptypb.Empty, error)
+	Grant(context.Context, *GrantRequest) (*emptypb.Empty, error)
+	SetGameProgress(context.Context, *GameProgress) (*emptypb.Empty, error)
+	GetEntity(context.Context, *Identifier) (*Entity, error)
+	SpawnMonster(context.Context, *SpawnMonsterRequest) (*Identifier, error)
+	DropItem(context.Context, *DropItemRequest) (*Identifier, error)
+	SetTile(context.Context, *SetTileRequest) (*emptypb.Empty, error)
+	PlaceEffect(context.Context, *PlaceEffectRequest) (*emptypb.Empty, error)
+	GetApiKeysUsage(context.Context, *emptypb.Empty) (*ApiKeysUsage, error)
+	GetBalance(context.Context, *emptypb.Empty) (*Balance, error)
+	Step(context.Context, *StepRequest) (*StepResult, error)
+	mustEmbedUnimplementedDungeonsAndTrollsAdminServer()
+}
+
+// UnimplementedDungeonsAndTrollsAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedDungeonsAndTrollsAdminServer struct {
+}
+
+func (UnimplementedDungeonsAndTrollsAdminServer) KickPlayer(context.Context, *KickPlayerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) RespawnPlayer(context.Context, *Identifier) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespawnPlayer not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) TeleportPlayer(context.Context, *TeleportRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeleportPlayer not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) RegenerateLevel(context.Context, *LevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateLevel not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) Grant(context.Context, *GrantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Grant not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) SetGameProgress(context.Context, *GameProgress) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameProgress not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) GetEntity(context.Context, *Identifier) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntity not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) SpawnMonster(context.Context, *SpawnMonsterRequest) (*Identifier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpawnMonster not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) DropItem(context.Context, *DropItemRequest) (*Identifier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropItem not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) SetTile(context.Context, *SetTileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTile not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) PlaceEffect(context.Context, *PlaceEffectRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceEffect not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) GetApiKeysUsage(context.Context, *emptypb.Empty) (*ApiKeysUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKeysUsage not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) GetBalance(context.Context, *emptypb.Empty) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) Step(context.Context, *StepRequest) (*StepResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Step not implemented")
+}
+func (UnimplementedDungeonsAndTrollsAdminServer) mustEmbedUnimplementedDungeonsAndTrollsAdminServer() {
+}
+
+// UnsafeDungeonsAndTrollsAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DungeonsAndTrollsAdminServer will
+// result in compilation errors.
+type UnsafeDungeonsAndTrollsAdminServer interface {
+	mustEmbedUnimplementedDungeonsAndTrollsAdminServer()
+}
+
+func RegisterDungeonsAndTrollsAdminServer(s grpc.ServiceRegistrar, srv DungeonsAndTrollsAdminServer) {
+	s.RegisterService(&DungeonsAndTrollsAdmin_ServiceDesc, srv)
+}
+
+func _DungeonsAndTrollsAdmin_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).KickPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/KickPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).KickPlayer(ctx, req.(*KickPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_RespawnPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).RespawnPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/RespawnPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).RespawnPlayer(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_TeleportPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeleportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).TeleportPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/TeleportPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).TeleportPlayer(ctx, req.(*TeleportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_RegenerateLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).RegenerateLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/RegenerateLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).RegenerateLevel(ctx, req.(*LevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_Grant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).Grant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/Grant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).Grant(ctx, req.(*GrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_SetGameProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameProgress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).SetGameProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/SetGameProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).SetGameProgress(ctx, req.(*GameProgress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_GetEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).GetEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/GetEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).GetEntity(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_SpawnMonster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpawnMonsterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).SpawnMonster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/SpawnMonster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).SpawnMonster(ctx, req.(*SpawnMonsterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_DropItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).DropItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/DropItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).DropItem(ctx, req.(*DropItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_SetTile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).SetTile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/SetTile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).SetTile(ctx, req.(*SetTileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_PlaceEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceEffectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).PlaceEffect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/PlaceEffect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).PlaceEffect(ctx, req.(*PlaceEffectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_GetApiKeysUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).GetApiKeysUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/GetApiKeysUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).GetApiKeysUsage(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).GetBalance(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DungeonsAndTrollsAdmin_Step_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DungeonsAndTrollsAdminServer).Step(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dungeonsandtrolls.DungeonsAndTrollsAdmin/Step",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DungeonsAndTrollsAdminServer).Step(ctx, req.(*StepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DungeonsAndTrollsAdmin_ServiceDesc is the grpc.ServiceDesc for DungeonsAndTrollsAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DungeonsAndTrollsAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dungeonsandtrolls.DungeonsAndTrollsAdmin",
+	HandlerType: (*DungeonsAndTrollsAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "KickPlayer",
+			Handler:    _DungeonsAndTrollsAdmin_KickPlayer_Handler,
+		},
+		{
+			MethodName: "RespawnPlayer",
+			Handler:    _DungeonsAndTrollsAdmin_RespawnPlayer_Handler,
+		},
+		{
+			MethodName: "TeleportPlayer",
+			Handler:    _DungeonsAndTrollsAdmin_TeleportPlayer_Handler,
+		},
+		{
+			MethodName: "RegenerateLevel",
+			Handler:    _DungeonsAndTrollsAdmin_RegenerateLevel_Handler,
+		},
+		{
+			MethodName: "Grant",
+			Handler:    _DungeonsAndTrollsAdmin_Grant_Handler,
+		},
+		{
+			MethodName: "SetGameProgress",
+			Handler:    _DungeonsAndTrollsAdmin_SetGameProgress_Handler,
+		},
+		{
+			MethodName: "GetEntity",
+			Handler:    _DungeonsAndTrollsAdmin_GetEntity_Handler,
+		},
+		{
+			MethodName: "SpawnMonster",
+			Handler:    _DungeonsAndTrollsAdmin_SpawnMonster_Handler,
+		},
+		{
+			MethodName: "DropItem",
+			Handler:    _DungeonsAndTrollsAdmin_DropItem_Handler,
+		},
+		{
+			MethodName: "SetTile",
+			Handler:    _DungeonsAndTrollsAdmin_SetTile_Handler,
+		},
+		{
+			MethodName: "PlaceEffect",
+			Handler:    _DungeonsAndTrollsAdmin_PlaceEffect_Handler,
+		},
+		{
+			MethodName: "GetApiKeysUsage",
+			Handler:    _DungeonsAndTrollsAdmin_GetApiKeysUsage_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _DungeonsAndTrollsAdmin_GetBalance_Handler,
+		},
+		{
+			MethodName: "Step",
+			Handler:    _DungeonsAndTrollsAdmin_Step_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/dungeonsandtrolls.proto",
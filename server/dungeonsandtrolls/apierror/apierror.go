@@ -0,0 +1,110 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+// Domain of the google.rpc.ErrorInfo details.
+const Domain = "dungeonsandtrolls"
+
+var grpcCodes = map[api.ErrorCode]codes.Code{
+	api.ErrorCode_NO_ERROR:                  codes.OK,
+	api.ErrorCode_UNKNOWN_ERROR:             codes.Unknown,
+	api.ErrorCode_STUNNED:                   codes.FailedPrecondition,
+	api.ErrorCode_OUT_OF_RANGE:              codes.OutOfRange,
+	api.ErrorCode_NO_LINE_OF_SIGHT:          codes.FailedPrecondition,
+	api.ErrorCode_INSUFFICIENT_FUNDS:        codes.FailedPrecondition,
+	api.ErrorCode_INSUFFICIENT_SKILL_POINTS: codes.FailedPrecondition,
+	api.ErrorCode_REQUIREMENTS_NOT_MET:      codes.FailedPrecondition,
+	api.ErrorCode_NOT_FOUND:                 codes.NotFound,
+	api.ErrorCode_INVALID_TARGET:            codes.InvalidArgument,
+	api.ErrorCode_INVALID_ARGUMENT:          codes.InvalidArgument,
+	api.ErrorCode_NO_PATH:                   codes.FailedPrecondition,
+	api.ErrorCode_CONFLICTING_COMMANDS:      codes.InvalidArgument,
+	api.ErrorCode_IN_COMBAT:                 codes.FailedPrecondition,
+	api.ErrorCode_NOT_AVAILABLE:             codes.FailedPrecondition,
+	api.ErrorCode_NOT_ADMIN:                 codes.PermissionDenied,
+	api.ErrorCode_ADMIN_NOT_ALLOWED:         codes.PermissionDenied,
+	api.ErrorCode_INVALID_API_KEY:           codes.Unauthenticated,
+	api.ErrorCode_ALREADY_EXISTS:            codes.AlreadyExists,
+	api.ErrorCode_PERMISSION_DENIED:         codes.PermissionDenied,
+	api.ErrorCode_RATE_LIMITED:              codes.ResourceExhausted,
+}
+
+// Error carries a machine-readable code (and optional details) which is reported to the clients.
+type Error struct {
+	Code     api.ErrorCode
+	Message  string
+	Metadata map[string]string
+	// Sent as google.rpc.RetryInfo when set.
+	RetryDelay time.Duration
+}
+
+func New(code api.ErrorCode, format string, a ...any) *Error {
+	return &Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// With adds a structured detail to the error (e.g. the required and the actual range).
+func (e *Error) With(key string, value any) *Error {
+	if e.Metadata == nil {
+		e.Metadata = map[string]string{}
+	}
+	e.Metadata[key] = fmt.Sprint(value)
+	return e
+}
+
+// WithRetryDelay tells the client when the request may be retried.
+func (e *Error) WithRetryDelay(d time.Duration) *Error {
+	e.RetryDelay = d
+	return e
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// GRPCStatus is used by gRPC to send the error with the proper status code and with the google.rpc.ErrorInfo
+// details. The gateway translates the status code to the matching HTTP status.
+func (e *Error) GRPCStatus() *status.Status {
+	c, ok := grpcCodes[e.Code]
+	if !ok {
+		c = codes.Unknown
+	}
+	s := status.New(c, e.Message)
+	details := []protoiface.MessageV1{&errdetails.ErrorInfo{
+		Reason:   e.Code.String(),
+		Domain:   Domain,
+		Metadata: e.Metadata,
+	}}
+	if e.RetryDelay > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryDelay)})
+	}
+	ds, err := s.WithDetails(details...)
+	if err != nil {
+		return s
+	}
+	return ds
+}
+
+// Code returns the code of the (possibly wrapped) error, errors without a code are UNKNOWN_ERROR.
+func Code(err error) api.ErrorCode {
+	if err == nil {
+		return api.ErrorCode_NO_ERROR
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return api.ErrorCode_UNKNOWN_ERROR
+}
@@ -0,0 +1,31 @@
+package apierror
+
+import (
+	"fmt"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestGRPCStatus(t *testing.T) {
+	err := fmt.Errorf("skill failed: %w", New(api.ErrorCode_OUT_OF_RANGE, "too far").With("range", 3))
+	if Code(err) != api.ErrorCode_OUT_OF_RANGE {
+		t.Fatalf("wrong code %s", Code(err))
+	}
+	s := status.Convert(err)
+	if s.Code() != codes.OutOfRange {
+		t.Fatalf("wrong status code %s", s.Code())
+	}
+	if len(s.Details()) != 1 {
+		t.Fatalf("wrong number of details %d", len(s.Details()))
+	}
+	info, ok := s.Details()[0].(*errdetails.ErrorInfo)
+	if !ok || info.Reason != "OUT_OF_RANGE" || info.Metadata["range"] != "3" {
+		t.Fatalf("wrong details %v", s.Details()[0])
+	}
+	if status.Convert(fmt.Errorf("plain")).Code() != codes.Unknown || Code(fmt.Errorf("plain")) != api.ErrorCode_UNKNOWN_ERROR {
+		t.Fatal("plain errors should be unknown")
+	}
+}
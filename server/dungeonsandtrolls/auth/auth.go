@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const ApiKeyFieldName = "X-API-key"
+
+type Role string
+
+const (
+	// RolePlayer controls its own Character.
+	RolePlayer Role = "player"
+	// RoleMonsterPuppeteer controls the monsters.
+	RoleMonsterPuppeteer Role = "monster-puppeteer"
+	// RoleViewer sees the game as its player does without controlling the Character (read-only keys).
+	RoleViewer Role = "viewer"
+	// RoleSpectator sees the full unfiltered map (without owning a Character).
+	RoleSpectator Role = "spectator"
+	// RoleOperator administers the game.
+	RoleOperator Role = "operator"
+)
+
+var knownRoles = []Role{RolePlayer, RoleViewer, RoleMonsterPuppeteer, RoleSpectator, RoleOperator}
+
+const servicePrefix = "/dungeonsandtrolls.DungeonsAndTrolls/"
+const adminServicePrefix = "/dungeonsandtrolls.DungeonsAndTrollsAdmin/"
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// methods which can be called without an API key
+var publicMethods = map[string]bool{
+	servicePrefix + "Game":        true,
+	servicePrefix + "GameLevel":   true,
+	servicePrefix + "WatchGame":   true,
+	servicePrefix + "Players":     true,
+	servicePrefix + "Levels":      true,
+	servicePrefix + "Register":    true,
+	servicePrefix + "Profile":     true,
+	servicePrefix + "Leaderboard": true,
+
+	healthServicePrefix + "Check": true,
+	healthServicePrefix + "Watch": true,
+}
+
+// roles allowed to call the method (methods which are not listed cannot be called at all)
+var methodRoles = map[string][]Role{
+	servicePrefix + "Buy":               {RolePlayer},
+	servicePrefix + "PickUp":            {RolePlayer},
+	servicePrefix + "Move":              {RolePlayer},
+	servicePrefix + "Respawn":           {RolePlayer},
+	servicePrefix + "Skill":             {RolePlayer},
+	servicePrefix + "Yell":              {RolePlayer},
+	servicePrefix + "Commands":          {RolePlayer},
+	servicePrefix + "Play":              {RolePlayer},
+	servicePrefix + "AssignSkillPoints": {RolePlayer},
+	servicePrefix + "MonstersCommands":  {RoleMonsterPuppeteer},
+	servicePrefix + "RotateApiKey":      {RolePlayer},
+	servicePrefix + "ListApiKeys":       {RolePlayer, RoleOperator},
+	servicePrefix + "RevokeApiKey":      {RolePlayer, RoleOperator},
+	servicePrefix + "IssueApiKey":       {RolePlayer, RoleOperator},
+
+	adminServicePrefix + "KickPlayer":      {RoleOperator},
+	adminServicePrefix + "RespawnPlayer":   {RoleOperator},
+	adminServicePrefix + "TeleportPlayer":  {RoleOperator},
+	adminServicePrefix + "RegenerateLevel": {RoleOperator},
+	adminServicePrefix + "Grant":           {RoleOperator},
+	adminServicePrefix + "SetGameProgress": {RoleOperator},
+	adminServicePrefix + "GetEntity":       {RoleOperator},
+	adminServicePrefix + "SpawnMonster":    {RoleOperator},
+	adminServicePrefix + "DropItem":        {RoleOperator},
+	adminServicePrefix + "SetTile":         {RoleOperator},
+	adminServicePrefix + "PlaceEffect":     {RoleOperator},
+	adminServicePrefix + "GetApiKeysUsage": {RoleOperator},
+	adminServicePrefix + "GetBalance":      {RoleOperator},
+	adminServicePrefix + "Step":            {RoleOperator},
+}
+
+// RoleProvider returns roles bound to the API key.
+type RoleProvider interface {
+	Roles(apiKey string) ([]Role, error)
+}
+
+func HasRole(roles []Role, role Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRoles converts the role names (e.g. from the API requests).
+func ParseRoles(names []string) ([]Role, error) {
+	var roles []Role
+	for _, n := range names {
+		r := Role(n)
+		if !HasRole(knownRoles, r) {
+			return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "unknown role %s", n)
+		}
+		roles = append(roles, r)
+	}
+	return roles, nil
+}
+
+func isAdmin(roles []Role) bool {
+	return HasRole(roles, RoleMonsterPuppeteer) || HasRole(roles, RoleOperator)
+}
+
+// ApiKey returns the API key sent in the request metadata.
+func ApiKey(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", apierror.New(api.ErrorCode_INVALID_API_KEY, "cannot read request metadata (api key is missing)")
+	}
+	tokens := md.Get(ApiKeyFieldName)
+	if len(tokens) != 1 {
+		return "", apierror.New(api.ErrorCode_INVALID_API_KEY, "incorrect number of auth tokens: %d", len(tokens))
+	}
+	return tokens[0], nil
+}
+
+func authorize(ctx context.Context, p RoleProvider, method string) error {
+	if publicMethods[method] {
+		return nil
+	}
+	allowed, ok := methodRoles[method]
+	if !ok {
+		return apierror.New(api.ErrorCode_PERMISSION_DENIED, "method %s is not allowed", method)
+	}
+	token, err := ApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	roles, err := p.Roles(token)
+	if err != nil {
+		return err
+	}
+	for _, r := range allowed {
+		if HasRole(roles, r) {
+			return nil
+		}
+	}
+	switch {
+	case isAdmin(allowed):
+		return apierror.New(api.ErrorCode_NOT_ADMIN, "you need to be an admin (%v) to do this", allowed)
+	case isAdmin(roles):
+		return apierror.New(api.ErrorCode_ADMIN_NOT_ALLOWED, "admin players are are not allowed to call non-admin methods")
+	}
+	return apierror.New(api.ErrorCode_PERMISSION_DENIED, "one of the roles %v is required", allowed)
+}
+
+// UnaryServerInterceptor rejects calls of methods which the roles bound to the API key are not allowed to call.
+func UnaryServerInterceptor(p RoleProvider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		err := authorize(ctx, p, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor.
+func StreamServerInterceptor(p RoleProvider) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := authorize(ss.Context(), p, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"google.golang.org/grpc/metadata"
+	"testing"
+)
+
+type staticRoles map[string][]Role
+
+func (s staticRoles) Roles(apiKey string) ([]Role, error) {
+	r, ok := s[apiKey]
+	if !ok {
+		return nil, apierror.New(api.ErrorCode_INVALID_API_KEY, "API key is not valid")
+	}
+	return r, nil
+}
+
+func TestAuthorize(t *testing.T) {
+	p := staticRoles{
+		"player":    {RolePlayer},
+		"puppeteer": {RoleMonsterPuppeteer},
+		"spectator": {RoleSpectator},
+		"operator":  {RoleOperator},
+	}
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(ApiKeyFieldName, key))
+	}
+	tests := []struct {
+		ctx    context.Context
+		method string
+		code   api.ErrorCode
+	}{
+		{context.Background(), servicePrefix + "Game", api.ErrorCode_NO_ERROR},
+		{context.Background(), healthServicePrefix + "Check", api.ErrorCode_NO_ERROR},
+		{context.Background(), servicePrefix + "Move", api.ErrorCode_INVALID_API_KEY},
+		{withKey("unknown"), servicePrefix + "Move", api.ErrorCode_INVALID_API_KEY},
+		{withKey("player"), servicePrefix + "Move", api.ErrorCode_NO_ERROR},
+		{withKey("player"), servicePrefix + "MonstersCommands", api.ErrorCode_NOT_ADMIN},
+		{withKey("puppeteer"), servicePrefix + "MonstersCommands", api.ErrorCode_NO_ERROR},
+		{withKey("puppeteer"), servicePrefix + "Move", api.ErrorCode_ADMIN_NOT_ALLOWED},
+		{withKey("spectator"), servicePrefix + "Move", api.ErrorCode_PERMISSION_DENIED},
+		{withKey("player"), "/unknown.Service/Method", api.ErrorCode_PERMISSION_DENIED},
+		{withKey("operator"), adminServicePrefix + "KickPlayer", api.ErrorCode_NO_ERROR},
+		{withKey("player"), adminServicePrefix + "KickPlayer", api.ErrorCode_NOT_ADMIN},
+		{withKey("operator"), servicePrefix + "Move", api.ErrorCode_ADMIN_NOT_ALLOWED},
+	}
+	for _, tt := range tests {
+		code := apierror.Code(authorize(tt.ctx, p, tt.method))
+		if code != tt.code {
+			t.Errorf("%s: expected %s, got %s", tt.method, tt.code, code)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package dungeonsandtrolls
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/rs/zerolog/log"
+	"go.openly.dev/pointy"
+)
+
+// Balance returns the active balance values. The caller has to hold the GameLock.
+func (g *Game) Balance() config.Balance {
+	return g.balance
+}
+
+// loadBalance reads the balance file over the configured balance, the values are applied on the next tick.
+// Returns the modification time of the loaded file.
+func (g *Game) loadBalance() (time.Time, error) {
+	path := g.Config.Game.BalanceFile
+	fi, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	b, err := config.LoadBalance(path, g.Config.Balance)
+
+	g.balanceLock.Lock()
+	defer g.balanceLock.Unlock()
+	g.balanceErr = err
+	if err != nil {
+		return fi.ModTime(), err
+	}
+	g.pendingBalance = &b
+	return fi.ModTime(), nil
+}
+
+// watchBalance reloads the balance file whenever it is modified (until the context is done). Invalid files are
+// reported and the active balance is kept.
+func (g *Game) watchBalance(ctx context.Context, modTime time.Time) {
+	t := time.NewTicker(g.Config.Game.CheckInterval())
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		fi, err := os.Stat(g.Config.Game.BalanceFile)
+		if err != nil || fi.ModTime().Equal(modTime) {
+			continue
+		}
+		modTime, err = g.loadBalance()
+		if err != nil {
+			log.Warn().Err(err).Msg("Balance was not reloaded")
+			continue
+		}
+		log.Info().Msgf("Balance reloaded from %s", g.Config.Game.BalanceFile)
+	}
+}
+
+// applyPendingBalance activates the reloaded balance and reports whether it changed. The caller has to hold the
+// GameLock.
+func (g *Game) applyPendingBalance() bool {
+	g.balanceLock.Lock()
+	defer g.balanceLock.Unlock()
+	if g.pendingBalance == nil {
+		return false
+	}
+	g.balance = *g.pendingBalance
+	g.balanceTick = g.Game.Tick
+	g.pendingBalance = nil
+	log.Info().Msgf("Balance applied %+v", g.balance)
+	return true
+}
+
+// GetBalance returns the active balance with the state of the reloads. The caller has to hold the GameLock.
+func (g *Game) GetBalance() *api.Balance {
+	b := &api.Balance{
+		ResistConstant:     g.balance.ResistConstant,
+		MinResist:          g.balance.MinResist,
+		BaseStat:           g.balance.BaseStat,
+		BaseResist:         g.balance.BaseResist,
+		MoneyBase:          g.balance.MoneyBase,
+		MoneyScoreFactor:   g.balance.MoneyScoreFactor,
+		MoneyScoreExponent: g.balance.MoneyScoreExponent,
+		OutOfCombatTicks:   g.balance.OutOfCombatTicks,
+		RangedDistance:     g.balance.RangedDistance,
+	}
+	if g.Config.Game.BalanceFile != "" {
+		b.File = pointy.String(g.Config.Game.BalanceFile)
+	}
+	g.balanceLock.Lock()
+	defer g.balanceLock.Unlock()
+	b.AppliedTick = g.balanceTick
+	if g.balanceErr != nil {
+		b.ReloadError = pointy.String(g.balanceErr.Error())
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+package dungeonsandtrolls
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+)
+
+func TestReloadBalance(t *testing.T) {
+	c := config.Default()
+	c.Game.BalanceFile = filepath.Join(t.TempDir(), "balance.toml")
+	g := NewGame(c)
+
+	if err := os.WriteFile(c.Game.BalanceFile, []byte("money_base = 1000.0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.loadBalance(); err != nil {
+		t.Fatal(err)
+	}
+	if g.GetMoney() != 420 {
+		t.Fatal("balance should not change before the next tick")
+	}
+	g.Game.Tick = 5
+	g.applyPendingBalance()
+	if g.GetMoney() != 1000 {
+		t.Fatalf("reloaded balance should be applied, money %d", g.GetMoney())
+	}
+
+	if err := os.WriteFile(c.Game.BalanceFile, []byte("base_stat = -1.0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.loadBalance(); err == nil {
+		t.Fatal("invalid balance should be rejected")
+	}
+	g.applyPendingBalance()
+	b := g.GetBalance()
+	if b.BaseStat != 100 || b.MoneyBase != 1000 || b.AppliedTick != 5 {
+		t.Fatalf("previous balance should be kept: %v", b)
+	}
+	if b.ReloadError == nil {
+		t.Fatal("reload error should be reported")
+	}
+}
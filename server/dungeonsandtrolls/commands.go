@@ -3,6 +3,7 @@ package dungeonsandtrolls
 import (
 	"fmt"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/utils"
 	"github.com/rs/zerolog/log"
@@ -14,7 +15,7 @@ import (
 // ValidateBuy validates identifiers, funds, and requirements
 func ValidateBuy(game *Game, p *gameobject.Player, identifiers *api.Identifiers) error {
 	if p.Stun().IsStunned {
-		return fmt.Errorf("you are stunned")
+		return apierror.New(api.ErrorCode_STUNNED, "you are stunned")
 	}
 
 	// this has to be a copy
@@ -34,11 +35,13 @@ func ValidateBuy(game *Game, p *gameobject.Player, identifiers *api.Identifiers)
 		}
 		i, ok := maybeItem.(*api.Item)
 		if !ok {
-			return fmt.Errorf("%s is not an Item ID", id)
+			return apierror.New(api.ErrorCode_INVALID_TARGET, "%s is not an Item ID", id)
 		}
 		playerMoney -= i.Price
 		if playerMoney < 0 {
-			return fmt.Errorf("insufficient funds to make the purchase")
+			return apierror.New(api.ErrorCode_INSUFFICIENT_FUNDS, "insufficient funds to make the purchase").
+				With("price", p.Character.Money-playerMoney).
+				With("money", p.Character.Money)
 		}
 		if i.Requirements != nil {
 			err := gameobject.MaxAllAttributes(requirements, i.Requirements, false)
@@ -65,7 +68,7 @@ func ValidateBuy(game *Game, p *gameobject.Player, identifiers *api.Identifiers)
 		return err
 	}
 	if !s {
-		return fmt.Errorf("item requirements are not satisfied")
+		return apierror.New(api.ErrorCode_REQUIREMENTS_NOT_MET, "item requirements are not satisfied")
 	}
 
 	return nil
@@ -94,11 +97,11 @@ func ExecuteBuy(game *Game, p *gameobject.Player, identifiers *api.Identifiers)
 		}
 		item, ok := maybeItem.(*api.Item)
 		if !ok {
-			return fmt.Errorf("%s is not Item ID", itemId)
+			return apierror.New(api.ErrorCode_INVALID_TARGET, "%s is not Item ID", itemId)
 		}
 		if p.Character.Money < item.Price {
 			// this should not happen (thanks to the validation above)
-			return fmt.Errorf("insufficient funds to make the purchase")
+			return apierror.New(api.ErrorCode_INSUFFICIENT_FUNDS, "insufficient funds to make the purchase")
 		}
 		p.Character.Money -= item.Price
 		buyEvent := api.Event_BUY
@@ -149,7 +152,7 @@ func ExecutePickUp(game *Game, p *gameobject.Player, i *api.Identifier) error {
 	if err != nil {
 		log.Warn().Err(err).Msgf("position of picked up item %s is malformed", i.GetId())
 	}
-	game.removeItemFromTile(o, item)
+	game.removeItemFromTile(o, item, p.GetPosition().Level)
 	return nil
 }
 
@@ -162,9 +165,10 @@ func payForSkill(p gameobject.Skiller, s *api.Skill) error {
 
 func summon(game *Game, sum *api.Droppable, player gameobject.Skiller, target *api.Coordinates, duration int32) {
 	po := game.GetMapObjectsOrCreateDefault(player.GetPosition())
+	game.markChanged(player.GetPosition())
 	switch so := sum.Data.(type) {
 	case *api.Droppable_Monster:
-		so.Monster.Id = gameobject.GetNewId()
+		so.Monster.Id = game.NewId()
 		if so.Monster.Faction == "inherited" {
 			switch p := player.(type) {
 			case *gameobject.Player:
@@ -176,6 +180,7 @@ func summon(game *Game, sum *api.Droppable, player gameobject.Skiller, target *a
 		po.Monsters = append(po.Monsters, so.Monster)
 		moGo := gameobject.CreateMonster(so.Monster, target)
 		moGo.KillCounter = &duration
+		moGo.SummonedBy = player.GetId()
 		game.Register(moGo)
 	case *api.Droppable_Decoration:
 		po.Decorations = append(po.Decorations, so.Decoration)
@@ -242,7 +247,7 @@ func findSkillerAndAddEffect(g *Game, tid string, damage float64, s *api.Skill,
 	}
 
 	if s.CasterEffects.Flags.Knockback {
-		gameobject.Knockback(so, casterPos)
+		gameobject.Knockback(so, casterPos, g.Rand())
 	}
 
 	return nil
@@ -251,7 +256,7 @@ func findSkillerAndAddEffect(g *Game, tid string, damage float64, s *api.Skill,
 func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error {
 	s, ok := player.GetSkill(su.SkillId)
 	if !ok {
-		return fmt.Errorf("skill %s not found for character", su.SkillId)
+		return apierror.New(api.ErrorCode_NOT_FOUND, "skill %s not found for character", su.SkillId)
 	}
 	skillEvent := api.Event_SKILL
 	aoeEvent := api.Event_AOE
@@ -262,7 +267,7 @@ func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error
 	}
 	distanceValue = gameobject.RoundRange(distanceValue)
 	ranged := false
-	if distanceValue > 3 {
+	if distanceValue > game.balance.RangedDistance {
 		ranged = true
 	}
 
@@ -271,6 +276,7 @@ func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error
 		Message:     fmt.Sprintf("%s (%s): used skill: %s (%s)", player.GetId(), player.GetName(), s.Id, s.Name),
 		SkillName:   &s.Name,
 		PlayerId:    pointy.String(player.GetId()),
+		CasterId:    pointy.String(player.GetId()),
 		Coordinates: player.GetPosition(),
 		Skill:       s,
 		IsRanged:    &ranged,
@@ -288,14 +294,14 @@ func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error
 		if err != nil {
 			return err
 		}
-		duration = gameobject.RoundSkill(d)
+		duration = gameobject.RoundSkill(d, game.Rand())
 	}
 
 	radiusValue, err := gameobject.AttributesValue(player.GetAttributes(), s.Radius)
 	if err != nil {
 		return err
 	}
-	radiusValue = gameobject.RoundSkill(radiusValue)
+	radiusValue = gameobject.RoundSkill(radiusValue, game.Rand())
 
 	d, err := gameobject.AttributesValue(player.GetAttributes(), s.DamageAmount)
 	if err != nil {
@@ -315,7 +321,7 @@ func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error
 		case *gameobject.Monster:
 			targetPos = ch.GetPosition()
 		default:
-			return fmt.Errorf("targetPos is not a monster or player")
+			return apierror.New(api.ErrorCode_INVALID_TARGET, "targetPos is not a monster or player")
 		}
 	case api.Skill_position:
 		targetPos = gameobject.PositionToCoordinates(su.Position, player.GetPosition().Level)
@@ -354,7 +360,7 @@ func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error
 		}
 
 		if s.CasterEffects.Flags.Knockback {
-			gameobject.Knockback(player, targetPos)
+			gameobject.Knockback(player, targetPos, game.Rand())
 		}
 	}
 
@@ -374,6 +380,7 @@ func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error
 		}
 
 		for _, t := range TilesInRange(game, player.GetPosition(), int32(radiusValue)) {
+			game.markChanged(gameobject.PositionToCoordinates(t.Position, player.GetPosition().Level))
 			t.Effects = append(t.Effects, &api.Effect{
 				Effects:      e,
 				DamageAmount: float32(d),
@@ -426,6 +433,7 @@ func ExecuteSkill(game *Game, player gameobject.Skiller, su *api.SkillUse) error
 		}
 
 		for _, t := range TilesInRange(game, targetPos, int32(radiusValue)) {
+			game.markChanged(gameobject.PositionToCoordinates(t.Position, targetPos.Level))
 			t.Effects = append(t.Effects, &api.Effect{
 				Effects:      e,
 				DamageAmount: float32(d),
@@ -570,18 +578,23 @@ func EvaluateEffects(g *Game, effects []*api.Effect, a *api.Attributes, receiver
 	// Deal damage
 	for _, e := range effects {
 		if e.DamageType != api.DamageType_none {
-			damage := gameobject.EvaluateDamage(float64(e.DamageAmount), e.DamageType, a)
+			alive := *a.Life > 0
+			damage := gameobject.EvaluateDamage(float64(e.DamageAmount), e.DamageType, a, g.balance, g.Rand())
 
 			var attackerName string
 			if e.XCasterId != nil {
 				attacker, err := g.GetObjectById(*e.XCasterId)
 				if err == nil {
 					attackerName = attacker.GetName()
+					g.recordDamage(attacker, receiver, damage, alive && *a.Life <= 0)
 				}
 			}
 
 			if damage > 0 {
 				receiver.DamageTaken()
+				if e.XCasterId != nil {
+					receiver.GetDamageLedger().Record(g.creditedId(*e.XCasterId), damage, g.Game.Tick, assistWindow)
+				}
 			}
 
 			damageEvent := api.Event_DAMAGE
@@ -590,6 +603,9 @@ func EvaluateEffects(g *Game, effects []*api.Effect, a *api.Attributes, receiver
 				Message:     fmt.Sprintf("%s (%s): damaged %s (%s): %f with %s", *e.XCasterId, attackerName, receiver.GetId(), receiver.GetName(), damage, e.DamageType.String()),
 				Damage:      &damage,
 				Coordinates: receiver.GetPosition(),
+				PlayerId:    e.XCasterId,
+				CasterId:    e.XCasterId,
+				VictimId:    pointy.String(receiver.GetId()),
 			})
 		}
 	}
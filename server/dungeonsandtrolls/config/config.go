@@ -0,0 +1,405 @@
+// Configuration of the server loaded from a TOML file with environment and flag overrides.
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+type Config struct {
+	Server      Server      `toml:"server"`
+	Storage     Storage     `toml:"storage"`
+	Generator   Generator   `toml:"generator"`
+	Game        Game        `toml:"game"`
+	Balance     Balance     `toml:"balance"`
+	KeyDelivery KeyDelivery `toml:"key_delivery"`
+	RateLimit   RateLimit   `toml:"rate_limit"`
+	Tracing     Tracing     `toml:"tracing"`
+	Recording   Recording   `toml:"recording"`
+}
+
+type Server struct {
+	GrpcPort    int    `toml:"grpc_port" env:"GRPC_PORT"`
+	HttpPort    int    `toml:"http_port" env:"HTTP_PORT"`
+	MetricsAddr string `toml:"metrics_addr" env:"METRICS_ADDR"`
+	// Time given to every phase of the shutdown (draining the requests, storing the game, stopping the servers).
+	ShutdownTimeout time.Duration `toml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	// API key with the operator role added on startup (the first operator key, further ones are issued by the operators).
+	OperatorKey string `toml:"operator_key" env:"OPERATOR_KEY"`
+}
+
+type Storage struct {
+	Path string `toml:"path" env:"STORAGE_PATH"`
+	// JSON files per bucket (json) or a single bbolt database (bolt) in the path.
+	Backend string `toml:"backend" env:"STORAGE_BACKEND"`
+}
+
+type Generator struct {
+	Binary string `toml:"binary" env:"GENERATOR_BINARY"`
+	// Flag of the generator taking the seed (passed only in the deterministic mode). Required by the deterministic and
+	// the recorded games, the levels would be random without it.
+	SeedFlag string `toml:"seed_flag" env:"GENERATOR_SEED_FLAG"`
+}
+
+type Game struct {
+	// Minimal duration of a tick, zero runs the ticks as fast as possible.
+	LoopTime time.Duration `toml:"loop_time" env:"LOOP_TIME"`
+	// Ticks are processed only when requested by the Step admin RPC.
+	StepMode bool `toml:"step_mode" env:"STEP_MODE"`
+	// The tick is processed once all the active players submitted their commands or after the LockstepTimeout.
+	Lockstep        bool          `toml:"lockstep" env:"LOCKSTEP"`
+	LockstepTimeout time.Duration `toml:"lockstep_timeout" env:"LOCKSTEP_TIMEOUT"`
+	// Players who missed this many ticks in a row (e.g. disconnected) are not waited for until they submit again.
+	LockstepIdleTicks int32 `toml:"lockstep_idle_ticks" env:"LOCKSTEP_IDLE_TICKS"`
+	// Ticks after which the ground floor is regenerated.
+	ZeroLevelLifetime int32 `toml:"zero_level_lifetime" env:"ZERO_LEVEL_LIFETIME"`
+	// Levels are regenerated after LevelLifetime + level / LevelLifetimeStep minutes (of 60 ticks).
+	LevelLifetime     int32 `toml:"level_lifetime" env:"LEVEL_LIFETIME"`
+	LevelLifetimeStep int32 `toml:"level_lifetime_step" env:"LEVEL_LIFETIME_STEP"`
+	PlayerYellLimit   int   `toml:"player_yell_limit" env:"PLAYER_YELL_LIMIT"`
+	MonsterYellLimit  int   `toml:"monster_yell_limit" env:"MONSTER_YELL_LIMIT"`
+	// Watched file overriding the balance section, the changes are applied on the next tick.
+	BalanceFile string `toml:"balance_file" env:"BALANCE_FILE"`
+	// Non-zero seed makes the game deterministic (the same commands give the same ticks).
+	Seed int64 `toml:"seed" env:"SEED"`
+}
+
+// Balance can be changed while the game runs (see Game.BalanceFile).
+type Balance struct {
+	// Damage is power * ResistConstant / (ResistConstant + max(resist, MinResist)).
+	ResistConstant float64 `toml:"resist_constant" env:"BALANCE_RESIST_CONSTANT"`
+	MinResist      float64 `toml:"min_resist" env:"BALANCE_MIN_RESIST"`
+	// Base stats of the players (life is 1.5 times the base stat).
+	BaseStat   float32 `toml:"base_stat" env:"BALANCE_BASE_STAT"`
+	BaseResist float32 `toml:"base_resist" env:"BALANCE_BASE_RESIST"`
+	// Money of a (re)spawned player is MoneyBase + (score * MoneyScoreFactor) ^ MoneyScoreExponent.
+	MoneyBase          float64 `toml:"money_base" env:"BALANCE_MONEY_BASE"`
+	MoneyScoreFactor   float64 `toml:"money_score_factor" env:"BALANCE_MONEY_SCORE_FACTOR"`
+	MoneyScoreExponent float64 `toml:"money_score_exponent" env:"BALANCE_MONEY_SCORE_EXPONENT"`
+	// Ticks without taking damage required by the out of combat skills.
+	OutOfCombatTicks int32 `toml:"out_of_combat_ticks" env:"BALANCE_OUT_OF_COMBAT_TICKS"`
+	// Skills with a longer range are ranged.
+	RangedDistance float64 `toml:"ranged_distance" env:"BALANCE_RANGED_DISTANCE"`
+}
+
+type KeyDelivery struct {
+	// discord, direct, stdout, file or webhook
+	Method     string `toml:"method" env:"KEY_DELIVERY"`
+	File       string `toml:"file" env:"KEY_DELIVERY_FILE"`
+	WebhookURL string `toml:"webhook_url" env:"KEY_DELIVERY_WEBHOOK_URL"`
+}
+
+// RateLimit is applied to every API key (zero disables the limit).
+type RateLimit struct {
+	RequestsPerSecond float64 `toml:"requests_per_second" env:"RATE_LIMIT_RPS"`
+	Burst             int     `toml:"burst" env:"RATE_LIMIT_BURST"`
+	// Blocking calls (waiting for the next tick) and streams running at the same time.
+	ConcurrentBlocking int `toml:"concurrent_blocking" env:"RATE_LIMIT_BLOCKING"`
+	// Comma separated addresses or CIDR ranges of the proxies trusted to set x-forwarded-for (the gateway connects
+	// from the loopback), calls without an API key are limited per the address before the trusted proxies.
+	TrustedProxies string `toml:"trusted_proxies" env:"RATE_LIMIT_TRUSTED_PROXIES"`
+}
+
+// TrustedProxyNetworks parses the TrustedProxies, single addresses are returned as networks with one address.
+func (r RateLimit) TrustedProxyNetworks() ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, s := range strings.Split(r.TrustedProxies, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address %q", s)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q", s)
+		}
+		networks = append(networks, n)
+	}
+	return networks, nil
+}
+
+type Tracing struct {
+	// none, otlp (configured by the standard OTEL_EXPORTER_OTLP_* variables) or file
+	Exporter string `toml:"exporter" env:"TRACING_EXPORTER"`
+	// spans are written to stdout if empty or "-"
+	File string `toml:"file" env:"TRACING_FILE"`
+}
+
+type Recording struct {
+	// Replay file with the commands and the events of every tick (see cmd/replay), the game runs with a seed then.
+	// The start time is added to the name (replay.jsonl is written to replay-20060102-150405.jsonl).
+	File string `toml:"file" env:"RECORDING_FILE"`
+}
+
+// Default returns the configuration of the production server.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			GrpcPort:        8081,
+			HttpPort:        8080,
+			MetricsAddr:     ":9090",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Storage: Storage{
+			Path:    "data/",
+			Backend: "json",
+		},
+		Generator: Generator{
+			Binary: "./generator/dntgenerator",
+		},
+		Game: Game{
+			LoopTime:          time.Second,
+			LockstepTimeout:   5 * time.Second,
+			LockstepIdleTicks: 3,
+			ZeroLevelLifetime: 30,
+			LevelLifetime:     10,
+			LevelLifetimeStep: 10,
+			PlayerYellLimit:   80,
+			MonsterYellLimit:  200,
+		},
+		Balance: Balance{
+			ResistConstant:     10,
+			MinResist:          -5,
+			BaseStat:           100,
+			BaseResist:         5,
+			MoneyBase:          420,
+			MoneyScoreFactor:   0.004,
+			MoneyScoreExponent: 0.75,
+			OutOfCombatTicks:   3,
+			RangedDistance:     3,
+		},
+		KeyDelivery: KeyDelivery{
+			Method: "discord",
+		},
+		RateLimit: RateLimit{
+			RequestsPerSecond:  20,
+			Burst:              40,
+			ConcurrentBlocking: 4,
+			TrustedProxies:     "127.0.0.1, ::1",
+		},
+		Tracing: Tracing{
+			Exporter: "none",
+		},
+	}
+}
+
+// Load reads the configuration. The defaults are overridden by the file (given by the -config flag or the CONFIG_FILE
+// variable), then by the environment variables and finally by the flags named after the keys (e.g. -game.loop_time).
+func Load(args []string) (*Config, error) {
+	c := Default()
+	fs := flag.NewFlagSet("dnt", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "path to the TOML configuration file")
+	// flags are applied after the file and the environment
+	flags := map[string]string{}
+	for _, f := range c.fields() {
+		f := f
+		fs.Func(f.name, fmt.Sprintf("overrides %s (env %s, default %v)", f.name, f.env, f.value.Interface()),
+			func(s string) error {
+				// checked early to report the wrong flag with the usage
+				if err := set(reflect.New(f.value.Type()).Elem(), s); err != nil {
+					return err
+				}
+				flags[f.name] = s
+				return nil
+			})
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *path != "" {
+		md, err := toml.DecodeFile(*path, c)
+		if err != nil {
+			return nil, fmt.Errorf("config file %s: %w", *path, err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("config file %s: unknown keys %v", *path, undecoded)
+		}
+	}
+	for _, f := range c.fields() {
+		if v, ok := os.LookupEnv(f.env); ok {
+			if err := set(f.value, v); err != nil {
+				return nil, fmt.Errorf("%s: %w", f.env, err)
+			}
+		}
+		if v, ok := flags[f.name]; ok {
+			if err := set(f.value, v); err != nil {
+				return nil, fmt.Errorf("-%s: %w", f.name, err)
+			}
+		}
+	}
+	return c, c.Validate()
+}
+
+// Validate checks the values which would break the server.
+func (c *Config) Validate() error {
+	var errs []string
+	if c.Game.LoopTime < 0 {
+		errs = append(errs, "game.loop_time cannot be negative")
+	}
+	if c.Game.Lockstep && c.Game.StepMode {
+		errs = append(errs, "game.lockstep cannot be combined with game.step_mode")
+	}
+	if c.Game.Lockstep && c.Game.LockstepTimeout <= 0 {
+		errs = append(errs, "game.lockstep_timeout has to be positive")
+	}
+	if c.Game.Lockstep && c.Game.LockstepIdleTicks <= 0 {
+		errs = append(errs, "game.lockstep_idle_ticks has to be positive")
+	}
+	if (c.Game.Seed != 0 || c.Recording.File != "") && c.Generator.SeedFlag == "" {
+		errs = append(errs, "generator.seed_flag is required by game.seed and recording.file")
+	}
+	if c.Game.LevelLifetimeStep <= 0 {
+		errs = append(errs, "game.level_lifetime_step has to be positive")
+	}
+	if c.Storage.Backend != "json" && c.Storage.Backend != "bolt" {
+		errs = append(errs, "storage.backend has to be json or bolt")
+	}
+	if c.Server.GrpcPort <= 0 || c.Server.HttpPort <= 0 {
+		errs = append(errs, "server ports have to be positive")
+	}
+	if _, err := c.RateLimit.TrustedProxyNetworks(); err != nil {
+		errs = append(errs, "rate_limit.trusted_proxies: "+err.Error())
+	}
+	if err := c.Balance.Validate(); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return errors.New("invalid configuration: " + strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// CheckInterval is the interval of the periodic checks running along the ticks (at least a second when the ticks are
+// not timed).
+func (g Game) CheckInterval() time.Duration {
+	if g.LoopTime <= 0 || g.StepMode || g.Lockstep {
+		return time.Second
+	}
+	return g.LoopTime
+}
+
+// Validate checks the values which would break the game.
+func (b *Balance) Validate() error {
+	var errs []string
+	if b.ResistConstant+b.MinResist <= 0 {
+		errs = append(errs, "balance.resist_constant + balance.min_resist has to be positive")
+	}
+	if b.BaseStat <= 0 {
+		errs = append(errs, "balance.base_stat has to be positive")
+	}
+	if b.MoneyBase < 0 || b.MoneyScoreFactor < 0 || b.MoneyScoreExponent < 0 {
+		errs = append(errs, "balance money values cannot be negative")
+	}
+	if b.OutOfCombatTicks < 0 {
+		errs = append(errs, "balance.out_of_combat_ticks cannot be negative")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// LoadBalance reads the balance file (with the keys of the balance section) over the base values.
+func LoadBalance(path string, base Balance) (Balance, error) {
+	b := base
+	md, err := toml.DecodeFile(path, &b)
+	if err != nil {
+		return base, fmt.Errorf("balance file %s: %w", path, err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		return base, fmt.Errorf("balance file %s: unknown keys %v", path, undecoded)
+	}
+	if err := b.Validate(); err != nil {
+		return base, fmt.Errorf("balance file %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// field is a single configurable value.
+type field struct {
+	// section.key
+	name  string
+	env   string
+	value reflect.Value
+}
+
+func (c *Config) fields() []field {
+	var fields []field
+	sections := reflect.ValueOf(c).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		sectionName := sections.Type().Field(i).Tag.Get("toml")
+		for j := 0; j < section.NumField(); j++ {
+			t := section.Type().Field(j)
+			fields = append(fields, field{
+				name:  sectionName + "." + t.Tag.Get("toml"),
+				env:   t.Tag.Get("env"),
+				value: section.Field(j),
+			})
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].name < fields[j].name
+	})
+	return fields
+}
+
+func set(v reflect.Value, s string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
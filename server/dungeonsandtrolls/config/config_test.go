@@ -0,0 +1,82 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	err := os.WriteFile(path, []byte(`
+[game]
+loop_time = "200ms"
+player_yell_limit = 10
+
+[rate_limit]
+burst = 5
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PLAYER_YELL_LIMIT", "20")
+	t.Setenv("RATE_LIMIT_BURST", "6")
+
+	c, err := Load([]string{"-config", path, "-rate_limit.burst", "7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Game.LoopTime != 200*time.Millisecond {
+		t.Errorf("loop time from the file expected, got %v", c.Game.LoopTime)
+	}
+	if c.Game.PlayerYellLimit != 20 {
+		t.Errorf("yell limit from the env expected, got %d", c.Game.PlayerYellLimit)
+	}
+	if c.RateLimit.Burst != 7 {
+		t.Errorf("burst from the flag expected, got %d", c.RateLimit.Burst)
+	}
+	if c.Game.MonsterYellLimit != Default().Game.MonsterYellLimit {
+		t.Errorf("default expected, got %d", c.Game.MonsterYellLimit)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("[game]\nloop_tme = \"1s\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load([]string{"-config", path}); err == nil {
+		t.Error("unknown key should be rejected")
+	}
+	if _, err := Load([]string{"-game.loop_time", "-1s"}); err == nil {
+		t.Error("negative loop time should be rejected")
+	}
+	if _, err := Load([]string{"-game.loop_time", "fast"}); err == nil {
+		t.Error("invalid duration should be rejected")
+	}
+	if _, err := Load([]string{"-rate_limit.trusted_proxies", "127.0.0.1, proxy"}); err == nil {
+		t.Error("invalid trusted proxy should be rejected")
+	}
+	if _, err := Load([]string{"-game.seed", "7"}); err == nil {
+		t.Error("seed without the generator seed flag should be rejected")
+	}
+}
+
+func TestExampleConfigs(t *testing.T) {
+	files, err := filepath.Glob("../../configs/*.toml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no example configs found: %v", err)
+	}
+	for _, f := range files {
+		if strings.HasPrefix(filepath.Base(f), "balance") {
+			_, err = LoadBalance(f, Default().Balance)
+		} else {
+			_, err = Load([]string{"-config", f})
+		}
+		if err != nil {
+			t.Errorf("%s: %v", f, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package dungeonsandtrolls
+
+import (
+	"sort"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+)
+
+// assistWindow is the number of the recent ticks the damage counts to the kill credit.
+const assistWindow = 10
+
+// creditedId resolves the summons to the objects which summoned them. It is resolved when the damage is dealt, so the
+// owners are credited even when their summons disappear before the death.
+func (g *Game) creditedId(id string) string {
+	// the summons may summon as well
+	for i := 0; i < 10; i++ {
+		o, err := g.GetObjectById(id)
+		if err != nil {
+			return id
+		}
+		m, ok := o.(*gameobject.Monster)
+		if !ok || m.SummonedBy == "" {
+			return id
+		}
+		id = m.SummonedBy
+	}
+	return id
+}
+
+// creditDeath splits the credit for the death among the objects which damaged the dead one in the recent ticks weighted
+// by the damage. The one who finished it gets the killing blow, the others get assists.
+func (g *Game) creditDeath(dead gameobject.Alive) (*string, []*api.KillCredit) {
+	damage := map[string]float32{}
+	for casterId, d := range dead.GetDamageLedger().Since(g.Game.Tick - assistWindow + 1) {
+		damage[casterId] += d
+	}
+	var killerId *string
+	if killer, ok := g.killers[dead.GetId()]; ok {
+		killerId = &killer
+		if _, ok := damage[killer]; !ok {
+			damage[killer] = 0
+		}
+	}
+	// the self inflicted damage is not credited
+	delete(damage, dead.GetId())
+
+	var total float32
+	for _, d := range damage {
+		total += d
+	}
+	var credits []*api.KillCredit
+	for id, d := range damage {
+		c := &api.KillCredit{Id: id, Damage: d, KillingBlow: killerId != nil && *killerId == id}
+		if total > 0 {
+			c.Share = d / total
+		} else if c.KillingBlow {
+			c.Share = 1
+		}
+		credits = append(credits, c)
+	}
+	sort.Slice(credits, func(i, j int) bool {
+		if credits[i].Share != credits[j].Share {
+			return credits[i].Share > credits[j].Share
+		}
+		return credits[i].Id < credits[j].Id
+	})
+
+	dead.GetDamageLedger().Reset()
+	return killerId, credits
+}
+
+// scoreCredits splits the score among the credited objects by their shares.
+func scoreCredits(credits []*api.KillCredit, score float32) []*api.KillCredit {
+	var scored []*api.KillCredit
+	for _, c := range credits {
+		s := score * c.Share
+		scored = append(scored, &api.KillCredit{Id: c.Id, Damage: c.Damage, Share: c.Share, KillingBlow: c.KillingBlow, Score: &s})
+	}
+	return scored
+}
@@ -0,0 +1,51 @@
+package dungeonsandtrolls
+
+import (
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"go.openly.dev/pointy"
+)
+
+func TestCreditDeath(t *testing.T) {
+	g := newTestGame(t)
+	defer g.stores.close()
+	alice := gameobject.CreatePlayer(g.NewId(), "alice", g.Balance())
+	g.AddPlayer(alice)
+	bob := gameobject.CreatePlayer(g.NewId(), "bob", g.Balance())
+	g.AddPlayer(bob)
+	pos := &api.Coordinates{PositionX: 3, PositionY: 3}
+	summon := gameobject.CreateMonster(&api.Monster{Id: g.NewId(), Attributes: &api.Attributes{}}, pos)
+	summon.SummonedBy = bob.GetId()
+	g.Register(summon)
+	troll := gameobject.CreateMonster(&api.Monster{Id: g.NewId(), Attributes: &api.Attributes{Life: pointy.Float32(10)}}, pos)
+	g.Register(troll)
+
+	troll.DamageLedger.Record(bob.GetId(), 100, 5, assistWindow)
+	g.Game.Tick = 20
+	troll.DamageLedger.Record(alice.GetId(), 30, 15, assistWindow)
+	troll.DamageLedger.Record(troll.GetId(), 50, 20, assistWindow)
+	summonId := summon.GetId()
+	hit := &api.Effect{Effects: &api.Attributes{}, DamageAmount: 10, DamageType: api.DamageType_slash, XCasterId: &summonId, Duration: 1}
+	if _, err := EvaluateEffects(g, []*api.Effect{hit}, troll.GetAttributes(), troll); err != nil {
+		t.Fatal(err)
+	}
+	// the summon is gone before its victim dies
+	g.Unregister(summon)
+
+	killerId, credits := g.creditDeath(troll)
+	if killerId == nil || *killerId != bob.GetId() {
+		t.Fatalf("owner of the summon should get the killing blow: %v", killerId)
+	}
+	if len(credits) != 2 || credits[0].Id != alice.GetId() || credits[0].Share != 0.75 || credits[0].KillingBlow ||
+		credits[1].Id != bob.GetId() || credits[1].Damage != 10 || !credits[1].KillingBlow {
+		t.Fatalf("only the recent damage should be credited: %v", credits)
+	}
+	if len(troll.DamageLedger.Records) != 0 {
+		t.Fatal("ledger should be reset at the death")
+	}
+	if s := scoreCredits(credits, 8); s[0].GetScore() != 6 || s[1].GetScore() != 2 {
+		t.Fatalf("score should be split by the shares: %v", s)
+	}
+}
@@ -0,0 +1,177 @@
+package dungeonsandtrolls
+
+import (
+	"sort"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+)
+
+// ChangeHistoryTicks is the number of ticks for which the changes are remembered (delta updates older than
+// that return the full state).
+const ChangeHistoryTicks = 300
+
+type tickChanges struct {
+	positions     map[int32]map[gameobject.PlainPos]struct{}
+	removedIds    []string
+	removedLevels []int32
+	// levels entered by the characters (by their IDs)
+	enteredLevels map[string][]int32
+}
+
+// Changes is everything which changed in the game since a tick.
+type Changes struct {
+	Since         int32
+	Positions     map[int32]map[gameobject.PlainPos]struct{}
+	RemovedIds    []string
+	RemovedLevels []int32
+	FullLevels    map[int32]struct{}
+	EnteredLevels map[string][]int32
+}
+
+// AddEnteredLevels makes the levels the character entered complete (the character did not see them before).
+func (c *Changes) AddEnteredLevels(id string) {
+	for _, l := range c.EnteredLevels[id] {
+		c.FullLevels[l] = struct{}{}
+	}
+}
+
+func (g *Game) currentTickChanges() *tickChanges {
+	tc, ok := g.changes[g.Game.Tick]
+	if !ok {
+		tc = &tickChanges{
+			positions:     map[int32]map[gameobject.PlainPos]struct{}{},
+			enteredLevels: map[string][]int32{},
+		}
+		g.changes[g.Game.Tick] = tc
+	}
+	return tc
+}
+
+// markChanged marks the tile on the coordinates as modified in the current tick.
+func (g *Game) markChanged(c *api.Coordinates) {
+	if c == nil {
+		return
+	}
+	g.changesLock.Lock()
+	defer g.changesLock.Unlock()
+	tc := g.currentTickChanges()
+	if _, ok := tc.positions[c.Level]; !ok {
+		tc.positions[c.Level] = map[gameobject.PlainPos]struct{}{}
+	}
+	tc.positions[c.Level][gameobject.PlainPos{PositionX: c.PositionX, PositionY: c.PositionY}] = struct{}{}
+}
+
+func (g *Game) markRemoved(id string) {
+	g.changesLock.Lock()
+	defer g.changesLock.Unlock()
+	tc := g.currentTickChanges()
+	tc.removedIds = append(tc.removedIds, id)
+}
+
+// markEnteredLevel marks that the character moved to another level (or was spawned) in the current tick.
+func (g *Game) markEnteredLevel(id string, l int32) {
+	g.changesLock.Lock()
+	defer g.changesLock.Unlock()
+	tc := g.currentTickChanges()
+	tc.enteredLevels[id] = append(tc.enteredLevels[id], l)
+}
+
+func (g *Game) markRemovedLevel(l int32) {
+	g.changesLock.Lock()
+	defer g.changesLock.Unlock()
+	tc := g.currentTickChanges()
+	tc.removedLevels = append(tc.removedLevels, l)
+}
+
+// pruneChanges forgets changes which are too old to be used for delta updates.
+func (g *Game) pruneChanges() {
+	g.changesLock.Lock()
+	defer g.changesLock.Unlock()
+	for t := range g.changes {
+		if t < g.Game.Tick-ChangeHistoryTicks {
+			delete(g.changes, t)
+		}
+	}
+}
+
+// ChangesSince collects all the changes made since the tick (including changes made during the tick).
+// The caller has to hold the GameLock. Returns false if the changes are no longer (or not yet) known.
+func (g *Game) ChangesSince(tick int32) (*Changes, bool) {
+	if tick > g.Game.Tick || tick < g.Game.Tick-ChangeHistoryTicks || tick < g.changesStart {
+		return nil, false
+	}
+	c := &Changes{
+		Since:         tick,
+		Positions:     map[int32]map[gameobject.PlainPos]struct{}{},
+		FullLevels:    map[int32]struct{}{},
+		EnteredLevels: map[string][]int32{},
+	}
+	for l, lc := range g.mapCache.Level {
+		if lc.GeneratedTick >= tick {
+			c.FullLevels[l] = struct{}{}
+		}
+	}
+
+	g.changesLock.Lock()
+	defer g.changesLock.Unlock()
+	for t, tc := range g.changes {
+		if t < tick {
+			continue
+		}
+		for l, positions := range tc.positions {
+			if _, ok := c.Positions[l]; !ok {
+				c.Positions[l] = map[gameobject.PlainPos]struct{}{}
+			}
+			for p := range positions {
+				c.Positions[l][p] = struct{}{}
+			}
+		}
+		c.RemovedIds = append(c.RemovedIds, tc.removedIds...)
+		for id, levels := range tc.enteredLevels {
+			c.EnteredLevels[id] = append(c.EnteredLevels[id], levels...)
+		}
+		for _, l := range tc.removedLevels {
+			// regenerated levels are sent complete
+			if _, ok := c.FullLevels[l]; !ok {
+				c.RemovedLevels = append(c.RemovedLevels, l)
+			}
+		}
+	}
+	sort.Strings(c.RemovedIds)
+	return c, true
+}
+
+func isEmptyTile(o *api.MapObjects) bool {
+	return len(o.Monsters) == 0 && len(o.Players) == 0 && len(o.Items) == 0 && len(o.Effects) == 0 &&
+		len(o.Decorations) == 0 && o.Portal == nil && o.IsSpawn == nil && o.IsFree && !o.IsWall && !o.IsDoor && !o.IsStairs
+}
+
+// ApplyDelta reduces the (already filtered) game state to the tiles which changed. Tiles with monsters or players
+// are always kept because their attributes change every tick.
+func ApplyDelta(gs *api.GameState, c *Changes) {
+	d := &api.GameStateDelta{
+		SinceTick:     c.Since,
+		RemovedIds:    c.RemovedIds,
+		RemovedLevels: c.RemovedLevels,
+	}
+	for _, l := range gs.Map.Levels {
+		if _, ok := c.FullLevels[l.Level]; ok {
+			d.FullLevels = append(d.FullLevels, l.Level)
+			continue
+		}
+		var keptObjects []*api.MapObjects
+		for _, o := range l.Objects {
+			_, changed := c.Positions[l.Level][gameobject.PlainPosFromApiPos(o.Position)]
+			if changed && isEmptyTile(o) {
+				d.RemovedPositions = append(d.RemovedPositions, gameobject.PositionToCoordinates(o.Position, l.Level))
+				continue
+			}
+			if changed || len(o.Monsters) > 0 || len(o.Players) > 0 {
+				keptObjects = append(keptObjects, o)
+			}
+		}
+		l.Objects = keptObjects
+	}
+	gs.Delta = d
+}
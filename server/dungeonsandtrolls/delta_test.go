@@ -0,0 +1,101 @@
+package dungeonsandtrolls
+
+import (
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+)
+
+func TestApplyDelta(t *testing.T) {
+	g := NewGame(config.Default())
+	g.Game.Tick = 10
+	g.markChanged(&api.Coordinates{Level: 1, PositionX: 1, PositionY: 1})
+	g.markChanged(&api.Coordinates{Level: 1, PositionX: 2, PositionY: 2})
+	g.markRemoved("monster")
+
+	gs := &api.GameState{
+		Map: &api.Map{
+			Levels: []*api.Level{
+				{
+					Level: 1,
+					Objects: []*api.MapObjects{
+						{Position: &api.Position{PositionX: 0, PositionY: 0}, IsWall: true},
+						{Position: &api.Position{PositionX: 1, PositionY: 1}, IsFree: true, Items: []*api.Item{{Id: "item"}}},
+						{Position: &api.Position{PositionX: 2, PositionY: 2}, IsFree: true},
+						{Position: &api.Position{PositionX: 3, PositionY: 3}, IsFree: true, Players: []*api.Character{{Id: "player"}}},
+					},
+				},
+			},
+		},
+	}
+
+	c, ok := g.ChangesSince(10)
+	if !ok {
+		t.Fatal("changes since the current tick should be known")
+	}
+	ApplyDelta(gs, c)
+
+	objects := gs.Map.Levels[0].Objects
+	if len(objects) != 2 {
+		t.Fatalf("expected changed tile and tile with player, got %d tiles", len(objects))
+	}
+	if objects[0].Position.PositionX != 1 || objects[1].Position.PositionX != 3 {
+		t.Fatal("unexpected tiles kept in delta")
+	}
+	if len(gs.Delta.RemovedPositions) != 1 || gs.Delta.RemovedPositions[0].PositionX != 2 {
+		t.Fatal("empty changed tile should be removed")
+	}
+	if len(gs.Delta.RemovedIds) != 1 || gs.Delta.RemovedIds[0] != "monster" {
+		t.Fatal("removed id missing")
+	}
+}
+
+func TestChangesSinceUnknown(t *testing.T) {
+	g := NewGame(config.Default())
+	g.Game.Tick = ChangeHistoryTicks + 10
+	if _, ok := g.ChangesSince(5); ok {
+		t.Fatal("changes older than the history should not be known")
+	}
+	if _, ok := g.ChangesSince(g.Game.Tick + 1); ok {
+		t.Fatal("changes from the future should not be known")
+	}
+	g.changesStart = g.Game.Tick
+	if _, ok := g.ChangesSince(g.Game.Tick - 1); ok {
+		t.Fatal("changes from before the start should not be known")
+	}
+}
+
+func TestEnteredLevelIsComplete(t *testing.T) {
+	g := NewGame(config.Default())
+	g.Game.Tick = 10
+	g.markEnteredLevel("player", 2)
+
+	level := func() *api.GameState {
+		return &api.GameState{
+			Map: &api.Map{
+				Levels: []*api.Level{
+					{
+						Level:   2,
+						Objects: []*api.MapObjects{{Position: &api.Position{PositionX: 0, PositionY: 0}, IsWall: true}},
+					},
+				},
+			},
+		}
+	}
+
+	c, _ := g.ChangesSince(10)
+	gs := level()
+	ApplyDelta(gs, c)
+	if len(gs.Map.Levels[0].Objects) != 0 {
+		t.Fatal("unchanged tiles should not be sent to the others")
+	}
+
+	c, _ = g.ChangesSince(10)
+	c.AddEnteredLevels("player")
+	gs = level()
+	ApplyDelta(gs, c)
+	if len(gs.Map.Levels[0].Objects) != 1 || len(gs.Delta.FullLevels) != 1 {
+		t.Fatal("entered level should be sent complete")
+	}
+}
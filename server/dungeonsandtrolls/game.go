@@ -1,72 +1,140 @@
 package dungeonsandtrolls
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
-	"path/filepath"
+	"math/rand"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
-	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/storage"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/metrics"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/replay"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/tracing"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/utils"
 	"github.com/gdg-garage/dungeons-and-trolls/server/generator"
 	"github.com/rs/zerolog/log"
+	"github.com/solarlune/paths"
+	"go.openly.dev/pointy"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/proto"
 )
 
-const LoopTime = time.Second
-
-const storageBasePath = "data/"
-const userStorageFile = "users.json"
-const gameStorageFile = "game.json"
-
-const gameStorageKey = "game"
-const gameTickStorageKey = "game_tick"
-
 type Game struct {
 	// Gained after kill (may be used in the next run)
-	Score           float32                       `json:"score"`
-	Players         map[string]*gameobject.Player `json:"-"`
-	ApiKeyToPlayer  map[string]*gameobject.Player `json:"player_api_keys"`
+	Score   float32                       `json:"score"`
+	Players map[string]*gameobject.Player `json:"players"`
+	// Legacy players stored by plaintext API keys, migrated to the hashed keys on load.
+	ApiKeyToPlayer  map[string]*gameobject.Player `json:"player_api_keys,omitempty"`
 	MaxLevelReached int32                         `json:"max_reached_level"`
+	BannedUsers     map[string]bool               `json:"banned_users"`
 	Game            api.GameState                 `json:"-"`
-	GameLock        sync.RWMutex                  `json:"-"`
+	GameLock        metrics.RWMutex               `json:"-"`
 	CommandsLock    sync.RWMutex                  `json:"-"`
 	TickCond        *sync.Cond                    `json:"-"`
+	Config          *config.Config                `json:"-"`
+
+	// closed when the game is asked to stop (no commands are accepted since then)
+	stopping <-chan struct{}
+	// set when the game loop stopped (guarded by the TickCond lock)
+	stopped bool
+	// closed when the game loop stopped and the game state was stored
+	done chan struct{}
+	// ticks requested in the step mode, the new tick is sent back once the tick is processed
+	steps chan chan<- int32
+	// submissions of the commands in the lockstep mode
+	lockstep *lockstep
+
+	// runs the generator (replaced by the recorded output in the replay)
+	generate func(start int32, end int32, max int32, seed int64) string
+	// draws the seed of the next generated levels (replaced by the recorded seeds in the replay)
+	generatorSeeds func() int64
+	// writes the replay file when the recording is enabled
+	recorder *replay.Writer
 
 	generatorLock sync.RWMutex
-
-	gameStorage *storage.Storage
-	userStorage *storage.Storage
+	keysLock      sync.RWMutex
+
+	stores *stores
+	// names of the players in the storage (the removed players are deleted from it)
+	storedPlayers map[string]bool
+	// lifetime stats of the players by their names
+	profiles map[string]*api.PlayerProfile
+	// stats of the current lives of the players by their names
+	runs map[string]*api.PlayerStats
+	// all time stats of the players by the stats periods (see statsPeriods) and by their names
+	stats map[int32]map[string]*api.PlayerStats
+	// stats periods merged or removed by the compaction since the stats were stored
+	changedStats map[int32]bool
+	// objects which finished the dying ones in the current tick (the owners of the summons) by the IDs of the dying ones
+	killers map[string]string
 
 	mapCache MapCache
 
 	idToObject map[string]gameobject.Ider
 
+	// API keys by their hashes, stored in the user storage.
+	apiKeys map[string]*ApiKey
+
 	Commands map[string]*api.CommandsBatch `json:"-"`
+	// span contexts of the requests which queued the commands per issuer ID
+	commandsTraces map[string]trace.SpanContext
+
+	// span of the currently processed part of the tick (events are recorded to it)
+	span trace.Span
 
 	Respawns []*gameobject.Player `json:"-"`
+
+	// map changes per tick used for delta updates
+	changes      map[int32]*tickChanges
+	changesLock  sync.Mutex
+	changesStart int32
+
+	// results of the executed commands per tick and issuer ID
+	commandResults map[int32]map[string]*api.CommandResult
+
+	// seeded by the configured seed in the deterministic mode
+	rand *rand.Rand
+	// seeds of the generated levels, separate from rand so the levels regenerated between the ticks do not change
+	// the random numbers of the ticks
+	generatorRand *rand.Rand
+
+	// active balance (changed only by the game loop)
+	balance config.Balance
+	// reloaded balance waiting for the next tick and the outcome of the reloads (guarded by balanceLock)
+	pendingBalance *config.Balance
+	balanceTick    int32
+	balanceErr     error
+	balanceLock    sync.Mutex
 }
 
-func NewGame() *Game {
-	gameStorage, err := storage.NewStorage(filepath.Join(storageBasePath, gameStorageFile))
+func NewGame(c *config.Config) *Game {
+	stores, err := openStores(c.Storage)
 	if err != nil {
-		log.Fatal().Msgf("Game storage init failed %v", err)
-	}
-	userStorage, err := storage.NewStorage(filepath.Join(storageBasePath, userStorageFile))
-	if err != nil {
-		log.Fatal().Msgf("User storage init failed %v", err)
+		log.Fatal().Msgf("Storage init failed %v", err)
 	}
 
 	g := &Game{
 		Players:         map[string]*gameobject.Player{},
-		ApiKeyToPlayer:  map[string]*gameobject.Player{},
-		gameStorage:     gameStorage,
-		userStorage:     userStorage,
+		apiKeys:         map[string]*ApiKey{},
+		BannedUsers:     map[string]bool{},
+		stores:          stores,
+		storedPlayers:   map[string]bool{},
+		profiles:        map[string]*api.PlayerProfile{},
+		runs:            map[string]*api.PlayerStats{},
+		stats:           map[int32]map[string]*api.PlayerStats{},
+		changedStats:    map[int32]bool{},
+		killers:         map[string]string{},
 		MaxLevelReached: 1,
 		Game: api.GameState{
 			Map: &api.Map{},
@@ -74,202 +142,452 @@ func NewGame() *Game {
 		mapCache: MapCache{
 			Level: map[int32]*LevelCache{},
 		},
-		Commands:   map[string]*api.CommandsBatch{},
-		idToObject: map[string]gameobject.Ider{},
-		Score:      0,
-		TickCond:   sync.NewCond(&sync.Mutex{}),
+		Commands:       map[string]*api.CommandsBatch{},
+		commandsTraces: map[string]trace.SpanContext{},
+		span:           trace.SpanFromContext(context.Background()),
+		idToObject:     map[string]gameobject.Ider{},
+		changes:        map[int32]*tickChanges{},
+		commandResults: map[int32]map[string]*api.CommandResult{},
+		Score:          0,
+		TickCond:       sync.NewCond(&sync.Mutex{}),
+		Config:         c,
+		rand:           newRand(c.Game.Seed),
+		balance:        c.Balance,
+		done:           make(chan struct{}),
+		steps:          make(chan chan<- int32),
+		lockstep:       newLockstep(),
+	}
+	g.generate = func(start int32, end int32, max int32, seed int64) string {
+		return generator.GenerateLevel(c.Generator.Binary, start, end, max, c.Generator.SeedFlag, seed)
+	}
+	g.generatorRand = newRand(g.rand.Int63())
+	g.generatorSeeds = func() int64 {
+		return g.generatorRand.Int63n(math.MaxInt64-1) + 1
 	}
 
 	return g
 }
 
-func CreateGame() (*Game, error) {
-	g := NewGame()
+// CreateGame loads the stored game and starts the game loop which runs until the context is done.
+func CreateGame(ctx context.Context, cfg *config.Config) (*Game, error) {
+	// the seed set below must not change the caller's configuration
+	copied := *cfg
+	c := &copied
+	if c.Recording.File != "" && c.Game.Seed == 0 {
+		// only deterministic games can be replayed
+		c.Game.Seed = time.Now().UnixNano()
+		log.Info().Msgf("Recorded game uses the seed %d", c.Game.Seed)
+	}
+	if c.Game.Seed != 0 && c.Generator.SeedFlag == "" {
+		return nil, errors.New("generator seed flag is not configured, the levels of the deterministic game would be random")
+	}
+	if err := migrateLegacyStorage(c.Storage); err != nil {
+		return nil, err
+	}
+	g := NewGame(c)
 
 	// TODO this needs to be properly thought out
 
-	err := g.gameStorage.ReadTo(gameTickStorageKey, &g.Game.Tick)
+	err := g.stores.scores.ReadTo(tickKey, &g.Game.Tick)
 	if err != nil {
 		log.Warn().Msgf("Game tick was not loaded from the storage %v", err)
 	}
-	err = g.gameStorage.ReadTo(gameStorageKey, g)
-	if err != nil {
+	// changes from the previous run are not known
+	g.changesStart = g.Game.Tick
+	err = g.loadGameState()
+	loaded := err == nil
+	if !loaded {
 		log.Warn().Msgf("Game was not loaded from the storage %v", err)
-	} else {
+	}
+	g.loadApiKeys()
+	g.bootstrapOperator()
+	world, err := g.loadWorld()
+	if err != nil {
+		log.Warn().Msgf("World was not loaded from the storage %v", err)
+	}
+
+	if c.Game.BalanceFile != "" {
+		modTime, err := g.loadBalance()
+		if err != nil {
+			g.stores.close()
+			return nil, err
+		}
+		g.applyPendingBalance()
+		go g.watchBalance(ctx, modTime)
+	}
+
+	if world != nil {
+		g.restoreWorld(world)
+	}
+
+	if c.Recording.File != "" {
+		if err := g.startRecording(replay.Name(c.Recording.File, time.Now()), loaded, world != nil); err != nil {
+			g.stores.close()
+			return nil, err
+		}
+	}
+
+	// without the world snapshot the stored players are respawned on a new ground floor
+	if loaded && world == nil {
 		g.AddLevels(0, 0)
 		g.handleStoredPlayers()
 	}
 
-	go g.gameLoop()
+	// the ground floor is needed to be ready before the first step
+	if c.Game.StepMode {
+		if _, err := g.GetCachedLevel(gameobject.ZeroLevel); err != nil {
+			g.AddLevel(gameobject.ZeroLevel)
+		}
+	}
+
+	g.stopping = ctx.Done()
+	go g.gameLoop(ctx)
 
 	return g, nil
 }
 
 func (g *Game) handleStoredPlayers() {
-	for key, p := range g.ApiKeyToPlayer {
-		if p.IsAdmin {
-			// Do not show admin users in the game
-			continue
-		}
-		g.AddPlayer(p, &api.Registration{ApiKey: &key})
+	for _, p := range g.sortedPlayers() {
+		g.addPlayer(p)
 	}
 }
 
-func (g *Game) storeGameState() {
-	g.gameStorage.Write(gameStorageKey, g)
-	g.gameStorage.Write(gameTickStorageKey, g.Game.Tick)
+func (g *Game) generateLevels(start int32, end int32) string {
+	seed := g.generatorSeed()
+	output := g.runGenerator(start, end, g.MaxLevelReached, seed)
+	g.recordLevels(start, end, g.MaxLevelReached, seed, output)
+	return output
 }
 
-func (g *Game) generateLevels(start int32, end int32) string {
+// runGenerator runs the generator without accessing the game (the caller does not need to hold the GameLock).
+func (g *Game) runGenerator(start int32, end int32, maxLevel int32, seed int64) string {
 	startGen := time.Now()
-	defer func(start time.Time) { log.Info().Msgf("Map generation took %s", time.Since(start)) }(startGen)
+	defer func(start time.Time) {
+		log.Info().Msgf("Map generation took %s", time.Since(start))
+		metrics.GeneratorDuration.Observe(time.Since(start).Seconds())
+	}(startGen)
 	g.generatorLock.Lock()
 	defer g.generatorLock.Unlock()
-	return generator.GenerateLevel(start, end, g.MaxLevelReached)
+	return g.generate(start, end, maxLevel, seed)
 }
 
-func (g *Game) gameLoop() {
+func (g *Game) recordLevels(start int32, end int32, maxLevel int32, seed int64, output string) {
+	g.record(&replay.Record{Levels: &replay.Levels{
+		Start:    start,
+		End:      end,
+		MaxLevel: maxLevel,
+		Seed:     seed,
+		Output:   output,
+	}})
+}
+
+func (g *Game) gameLoop(ctx context.Context) {
+	defer g.stop()
 	for {
+		if ctx.Err() != nil {
+			return
+		}
+		var stepped chan<- int32
+		if g.Config.Game.StepMode {
+			select {
+			case <-ctx.Done():
+				return
+			case stepped = <-g.steps:
+			}
+		}
 		startTime := time.Now()
-		g.GameLock.Lock()
-		g.Game.Events = []*api.Event{}
+		tick := g.tick()
 
-		for _, r := range g.Respawns {
-			log.Info().Msgf("respawning player %s (%s)", r.GetId(), r.GetName())
-			g.Respawn(r, true)
+		tickDuration := time.Since(startTime)
+		metrics.TickDuration.Observe(tickDuration.Seconds())
+		if stepped != nil {
+			stepped <- tick
+			continue
 		}
-		g.Respawns = []*gameobject.Player{}
+		if g.Config.Game.Lockstep {
+			g.waitForSubmissions(ctx)
+			continue
+		}
+		if g.Config.Game.LoopTime <= 0 {
+			// as fast as possible
+			continue
+		}
+		metrics.TickUtilization.Observe(float64(tickDuration) / float64(g.Config.Game.LoopTime))
+		if tickDuration > g.Config.Game.LoopTime {
+			metrics.TickOverruns.Inc()
+		}
+		//log.Debug().Msgf("sleeping for %v", g.Config.Game.LoopTime-time.Since(startTime))
+		select {
+		case <-ctx.Done():
+		case <-time.After(g.Config.Game.LoopTime - time.Since(startTime)):
+		}
+	}
+}
 
-		g.processCommands()
+// tick processes the commands queued for the current tick and returns the next tick.
+func (g *Game) tick() int32 {
+	tickCtx, tickSpan := tracing.Tracer().Start(context.Background(), "tick")
+	g.GameLock.Lock()
+	tickSpan.SetAttributes(attribute.Int("tick", int(g.Game.Tick)))
+	g.span = tickSpan
+	g.Game.Events = []*api.Event{}
+	if g.Config.Game.Lockstep {
+		g.reportMissedSubmissions()
+	}
+	balanceApplied := g.applyPendingBalance()
+	g.recordInput(balanceApplied)
 
-		// Copy score - for storage reasons
-		// TODO maybe use the same solution as for tick or find something more elegant
-		g.Game.Score = g.Score
+	// the ground floor is not generated on a fresh start
+	if _, err := g.GetCachedLevel(gameobject.ZeroLevel); err != nil {
+		g.AddLevel(gameobject.ZeroLevel)
+	}
 
-		// mark active levels
-		for _, p := range g.Players {
-			lc, err := g.GetCachedLevel(p.GetPosition().Level)
-			if err != nil {
-				log.Warn().Msgf("Getting level cache for %d failed", p.GetPosition().Level)
-			} else {
-				lc.LastInteractedTick = g.Game.Tick
-			}
+	for _, r := range g.Respawns {
+		log.Info().Msgf("respawning player %s (%s)", r.GetId(), r.GetName())
+		g.Respawn(r, true)
+	}
+	g.Respawns = []*gameobject.Player{}
+
+	g.processCommands(tickCtx)
+	g.recordStats()
+
+	// Copy score - for storage reasons
+	// TODO maybe use the same solution as for tick or find something more elegant
+	g.Game.Score = g.Score
+
+	// mark active levels
+	for _, p := range g.sortedPlayers() {
+		lc, err := g.GetCachedLevel(p.GetPosition().Level)
+		if err != nil {
+			log.Warn().Msgf("Getting level cache for %d failed", p.GetPosition().Level)
+		} else {
+			lc.LastInteractedTick = g.Game.Tick
 		}
+	}
 
-		//for l, lc := range g.mapCache.Level {
-		//	log.Info().Msgf("Level %d age %d last interacted %d", l, g.Game.Tick-lc.GeneratedTick, g.Game.Tick-lc.LastInteractedTick)
-		//}
+	//for l, lc := range g.mapCache.Level {
+	//	log.Info().Msgf("Level %d age %d last interacted %d", l, g.Game.Tick-lc.GeneratedTick, g.Game.Tick-lc.LastInteractedTick)
+	//}
 
-		// regenerate levels
-		var respawnPlayers []*gameobject.Player
-		var deprecatedLevels []int32
-		var deprecatedZero bool
+	// regenerate levels
+	var respawnPlayers []*gameobject.Player
+	var deprecatedLevels []int32
+	var deprecatedZero bool
 
-		// Propagate level age timeout
-		for _, l := range g.Game.Map.Levels {
-			lc, err := g.GetCachedLevel(l.Level)
-			if err != nil {
-				log.Warn().Err(err).Msgf("level cache missing for %d", l.Level)
+	// Propagate level age timeout
+	for _, l := range g.Game.Map.Levels {
+		lc, err := g.GetCachedLevel(l.Level)
+		if err != nil {
+			log.Warn().Err(err).Msgf("level cache missing for %d", l.Level)
+		} else {
+			if l.Level == 0 {
+				l.DeprecationInSeconds = g.Config.Game.ZeroLevelLifetime - (g.Game.Tick - lc.GeneratedTick)
 			} else {
-				if l.Level == 0 {
-					l.DeprecationInSeconds = 30 - (g.Game.Tick - lc.GeneratedTick)
-				} else {
-					l.DeprecationInSeconds = (LevelAgeTimeout(l.Level) * 60) - (g.Game.Tick - lc.GeneratedTick)
-				}
+				l.DeprecationInSeconds = (g.LevelAgeTimeout(l.Level) * 60) - (g.Game.Tick - lc.GeneratedTick)
 			}
 		}
+	}
 
-		for l, lc := range g.mapCache.Level {
-			if IsMapDeprecated(lc, g.Game.Tick, l) {
-				// map garbage collection
-				log.Info().Msgf("Garbage collecting level %d", l)
-				for _, i := range lc.Objects {
-					for _, o := range i {
-						for _, p := range o.Players {
-							if l != 0 {
-								log.Warn().Msgf("Player %s (%s) is on a dead level (%d) - respawning", p.GetId(), p.GetName(), l)
-								pl, err := g.GetObjectById(p.GetId())
-								if err != nil {
-									log.Warn().Err(err).Msg("")
-								} else {
-									player := pl.(*gameobject.Player)
-									respawnPlayers = append(respawnPlayers, player)
-									player.SetPosition(nil)
-								}
-							}
-						}
-						for _, j := range o.Items {
-							g.Unregister(j)
-						}
-						for _, j := range o.Monsters {
-							g.Unregister(j)
-						}
-					}
-				}
-				deprecatedLevels = append(deprecatedLevels, l)
-				if l == 0 {
-					deprecatedZero = true
-				}
+	levels := maps.Keys(g.mapCache.Level)
+	slices.Sort(levels)
+	for _, l := range levels {
+		lc := g.mapCache.Level[l]
+		if g.IsMapDeprecated(lc, g.Game.Tick, l) {
+			// map garbage collection
+			log.Info().Msgf("Garbage collecting level %d", l)
+			respawnPlayers = append(respawnPlayers, g.unregisterLevel(l, lc)...)
+			deprecatedLevels = append(deprecatedLevels, l)
+			if l == 0 {
+				deprecatedZero = true
 			}
 		}
-		for _, l := range deprecatedLevels {
-			g.mapCache.ClearLevelCache(l)
-			// TODO check all valid
-			// - go through objects and remove empty ones
-			// - sort by position
-			// - update the cache
-			// - unregister IDs
-			for i, lvl := range g.Game.Map.Levels {
-				if lvl.Level == l {
-					g.Game.Map.Levels[i] = g.Game.Map.Levels[len(g.Game.Map.Levels)-1]
-					g.Game.Map.Levels = g.Game.Map.Levels[:len(g.Game.Map.Levels)-1]
-					break
-				}
-			}
+	}
+	for _, l := range deprecatedLevels {
+		g.removeLevel(l)
+	}
+	if deprecatedZero {
+		g.AddLevel(0)
+		g.returnPlayersToZeroLevel()
+	}
+	for _, p := range respawnPlayers {
+		log.Info().Msgf("Player %s (%s) is respawned because it was on a dead level", p.GetId(), p.GetName())
+		g.Respawn(p, false)
+	}
+	g.SortMaps()
+	g.pruneChanges()
+	g.updateObjectMetrics()
+
+	if g.Config.Game.Lockstep {
+		g.expectSubmissions()
+	}
+
+	g.TickCond.L.Lock()
+	g.Game.Tick++
+	tick := g.Game.Tick
+	g.TickCond.L.Unlock()
+	g.TickCond.Broadcast()
+	g.recordOutput(tick - 1)
+
+	g.span = trace.SpanFromContext(context.Background())
+	g.GameLock.Unlock()
+	g.storeGameState()
+	tickSpan.End()
+	return tick
+}
+
+// stop stores the final game state and wakes up everyone waiting for the next tick.
+func (g *Game) stop() {
+	g.storeGameState()
+	g.stores.close()
+
+	g.stopRecording()
+
+	g.TickCond.L.Lock()
+	g.stopped = true
+	g.TickCond.L.Unlock()
+	g.TickCond.Broadcast()
+	close(g.done)
+	log.Info().Msgf("Game loop stopped at tick %d", g.Game.Tick)
+}
+
+// Stopping reports whether the game was asked to stop.
+func (g *Game) Stopping() bool {
+	select {
+	case <-g.stopping:
+		return true
+	default:
+		return false
+	}
+}
+
+// Done is closed when the game loop stopped and the game state was stored.
+func (g *Game) Done() <-chan struct{} {
+	return g.done
+}
+
+// Ready returns an error if the game cannot serve the players (yet or any more).
+func (g *Game) Ready() error {
+	if g.Stopping() {
+		return errors.New("the game is stopping")
+	}
+	if err := generator.Check(g.Config.Generator.Binary); err != nil {
+		return err
+	}
+	g.GameLock.RLock()
+	defer g.GameLock.RUnlock()
+	if _, err := g.GetCachedLevel(gameobject.ZeroLevel); err != nil {
+		return fmt.Errorf("the ground floor does not exist yet: %w", err)
+	}
+	return nil
+}
+
+// updateObjectMetrics counts the registered objects per level.
+func (g *Game) updateObjectMetrics() {
+	players := map[string]int{}
+	monsters := map[string]int{}
+	objects := map[string]int{}
+	for _, o := range g.idToObject {
+		level := "none"
+		if p, ok := o.(gameobject.Positioner); ok && p.GetPosition() != nil {
+			level = strconv.Itoa(int(p.GetPosition().Level))
+		}
+		objects[level]++
+		switch o.(type) {
+		case *gameobject.Player:
+			players[level]++
+		case *gameobject.Monster:
+			monsters[level]++
 		}
-		if deprecatedZero {
-			g.AddLevel(0)
-			// respawn players on level 0
-			for _, p := range g.Players {
-				if p.GetPosition().Level == 0 {
-
-					previousPosition := proto.Clone(p.GetPosition())
-					p.SetPosition(nil)
-					g.ForceMoveCharacter(p, previousPosition.(*api.Coordinates))
+	}
+	metrics.Players.Reset()
+	metrics.Monsters.Reset()
+	metrics.Objects.Reset()
+	for l, c := range objects {
+		metrics.Players.WithLabelValues(l).Set(float64(players[l]))
+		metrics.Monsters.WithLabelValues(l).Set(float64(monsters[l]))
+		metrics.Objects.WithLabelValues(l).Set(float64(c))
+	}
+}
+
+// unregisterLevel unregisters all objects on the level. Players which have to be respawned (all players which are
+// not on the ground floor) are returned.
+func (g *Game) unregisterLevel(l int32, lc *LevelCache) []*gameobject.Player {
+	var respawnPlayers []*gameobject.Player
+	// sorted so the players are respawned in the same order (in the deterministic mode)
+	xs := maps.Keys(lc.Objects)
+	slices.Sort(xs)
+	for _, x := range xs {
+		ys := maps.Keys(lc.Objects[x])
+		slices.Sort(ys)
+		for _, y := range ys {
+			o := lc.Objects[x][y]
+			for _, p := range o.Players {
+				if l != 0 {
+					log.Warn().Msgf("Player %s (%s) is on a dead level (%d) - respawning", p.GetId(), p.GetName(), l)
+					pl, err := g.GetObjectById(p.GetId())
+					if err != nil {
+						log.Warn().Err(err).Msg("")
+					} else {
+						player := pl.(*gameobject.Player)
+						respawnPlayers = append(respawnPlayers, player)
+						player.SetPosition(nil)
+					}
 				}
 			}
+			for _, j := range o.Items {
+				g.Unregister(j)
+			}
+			for _, j := range o.Monsters {
+				g.Unregister(j)
+			}
 		}
-		for _, p := range respawnPlayers {
-			log.Info().Msgf("Player %s (%s) is respawned because it was on a dead level", p.GetId(), p.GetName())
-			g.Respawn(p, false)
-		}
-		g.SortMaps()
+	}
+	return respawnPlayers
+}
 
-		g.TickCond.L.Lock()
-		g.Game.Tick++
-		g.TickCond.L.Unlock()
-		g.TickCond.Broadcast()
+// removeLevel removes the level from the map and from the cache.
+func (g *Game) removeLevel(l int32) {
+	g.mapCache.ClearLevelCache(l)
+	g.markRemovedLevel(l)
+	// TODO check all valid
+	// - go through objects and remove empty ones
+	// - sort by position
+	// - update the cache
+	// - unregister IDs
+	for i, lvl := range g.Game.Map.Levels {
+		if lvl.Level == l {
+			g.Game.Map.Levels[i] = g.Game.Map.Levels[len(g.Game.Map.Levels)-1]
+			g.Game.Map.Levels = g.Game.Map.Levels[:len(g.Game.Map.Levels)-1]
+			break
+		}
+	}
+}
 
-		g.GameLock.Unlock()
-		g.storeGameState()
+// returnPlayersToZeroLevel places the players back to their positions on the regenerated ground floor.
+func (g *Game) returnPlayersToZeroLevel() {
+	for _, p := range g.sortedPlayers() {
+		if p.GetPosition().Level == 0 {
 
-		//log.Debug().Msgf("sleeping for %v", LoopTime-time.Since(startTime))
-		time.Sleep(LoopTime - time.Since(startTime))
+			previousPosition := proto.Clone(p.GetPosition())
+			p.SetPosition(nil)
+			g.ForceMoveCharacter(p, previousPosition.(*api.Coordinates))
+		}
 	}
 }
 
-func LevelAgeTimeout(l int32) int32 {
-	return 10 + (l / 10)
+// LevelAgeTimeout returns the lifetime of the level in minutes.
+func (g *Game) LevelAgeTimeout(l int32) int32 {
+	return g.Config.Game.LevelLifetime + (l / g.Config.Game.LevelLifetimeStep)
 }
 
-func IsMapDeprecated(mm *LevelCache, t int32, l int32) bool {
+func (g *Game) IsMapDeprecated(mm *LevelCache, t int32, l int32) bool {
 	if l == 0 {
-		if (t - mm.GeneratedTick) > 30 {
+		if (t - mm.GeneratedTick) > g.Config.Game.ZeroLevelLifetime {
 			log.Info().Msgf("first level is deprecated")
 			return true
 		}
 	} else {
-		if (t - mm.GeneratedTick) > LevelAgeTimeout(l)*60 {
+		if (t - mm.GeneratedTick) > g.LevelAgeTimeout(l)*60 {
 			log.Info().Msgf("%d level is deprecated due to age", l)
 			return true
 		}
@@ -286,7 +604,13 @@ func (g *Game) AddLevels(start int32, end int32) {
 	if err != nil {
 		log.Fatal().Err(err).Msg("Parsing map failed")
 	}
-	err = LevelsPostProcessing(g, m, &g.mapCache)
+	g.addIds(m)
+	g.AddParsedLevels(m)
+}
+
+// AddParsedLevels adds the levels which were already generated and parsed.
+func (g *Game) AddParsedLevels(m *api.Map) {
+	err := LevelsPostProcessing(g, m, &g.mapCache)
 	if err != nil {
 		log.Warn().Err(err).Msg("")
 	}
@@ -316,12 +640,23 @@ func (g *Game) Respawn(player *gameobject.Player, markDeath bool) {
 	player.SetMovingTo(nil)
 
 	if markDeath {
+		g.profile(player.GetName()).Deaths++
 		deathEvent := api.Event_DEATH
-		g.LogEvent(&api.Event{
+		event := &api.Event{
 			Type:        &deathEvent,
 			Message:     fmt.Sprintf("%s (%s) died", player.GetId(), player.Character.Name),
 			Coordinates: player.GetPosition(),
-		})
+			PlayerId:    pointy.String(player.GetId()),
+			VictimId:    pointy.String(player.GetId()),
+		}
+		if player.GetAttributes().GetLife() <= 0 {
+			event.KillerId, event.Credits = g.creditDeath(player)
+		}
+		g.LogEvent(event)
+	}
+	player.DamageLedger.Reset()
+	if _, ok := g.runs[player.GetName()]; markDeath || !ok {
+		g.startRun(player)
 	}
 
 	if player.GetPosition() != nil {
@@ -331,12 +666,14 @@ func (g *Game) Respawn(player *gameobject.Player, markDeath bool) {
 			player.SetPosition(nil)
 		} else if o != nil {
 			RemovePlayerFromTile(o, player)
+			g.markChanged(player.GetPosition())
 		}
 	}
 	g.SpawnPlayer(player, gameobject.ZeroLevel)
-	player.InitAttributes()
+	player.InitAttributes(g.balance)
 	player.UpdateAttributes()
 	player.Character.Money = g.GetMoney()
+	g.profile(player.GetName()).MoneyReceived += player.Character.Money
 	player.Character.Stun = &api.Stun{}
 	player.Character.SkillPoints = float32(g.MaxLevelReached)
 	player.Character.Equip = []*api.Item{}
@@ -346,9 +683,14 @@ func (g *Game) Respawn(player *gameobject.Player, markDeath bool) {
 	g.Register(player)
 }
 
-func (g *Game) AddPlayer(player *gameobject.Player, registration *api.Registration) {
+// AddPlayer adds the newly registered player. The caller has to hold the GameLock.
+func (g *Game) AddPlayer(player *gameobject.Player) {
+	g.record(&replay.Record{Player: &replay.Player{Id: player.GetId(), Name: player.GetName()}})
+	g.addPlayer(player)
+}
+
+func (g *Game) addPlayer(player *gameobject.Player) {
 	g.Players[player.Character.Name] = player
-	g.ApiKeyToPlayer[*registration.ApiKey] = player
 	g.Respawn(player, false)
 }
 
@@ -361,6 +703,8 @@ func (g *Game) AddItem(item *api.Item) {
 
 func (g *Game) LogEvent(event *api.Event) {
 	g.Game.Events = append(g.Game.Events, event)
+	metrics.Events.WithLabelValues(event.GetType().String()).Inc()
+	g.span.AddEvent(event.GetType().String(), trace.WithAttributes(attribute.String("message", event.GetMessage())))
 	log.Info().Msgf(event.String())
 }
 
@@ -390,12 +734,32 @@ func (g *Game) processPassives(p gameobject.Skiller) {
 
 }
 
-func (g *Game) processCommands() {
+// executionStatus converts the outcome of the command execution to the status reported to the issuer.
+func executionStatus(s gameobject.Skiller, command string, err error) *api.CommandStatus {
+	if err == nil {
+		return &api.CommandStatus{Status: api.CommandStatus_EXECUTED}
+	}
+	message := fmt.Sprintf("failed to %s: %s", command, err.Error())
+	log.Info().Msgf("%s (%s): %s", s.GetId(), s.GetName(), message)
+	return &api.CommandStatus{
+		Status:    api.CommandStatus_FAILED,
+		ErrorCode: apierror.Code(err),
+		Message:   pointy.String(message),
+	}
+}
+
+// CommandResult returns the result of the commands issued by the object which were executed in the tick (nil if
+// there were none). The caller has to hold the GameLock.
+func (g *Game) CommandResult(tick int32, id string) *api.CommandResult {
+	return g.commandResults[tick][id]
+}
+
+func (g *Game) processCommands(ctx context.Context) {
 	errorEvent := api.Event_ERROR
 	deathEvent := api.Event_DEATH
 	scoreEvent := api.Event_SCORE
 
-	for _, i := range g.idToObject {
+	for _, i := range g.sortedObjects() {
 		switch c := i.(type) {
 		case *gameobject.Monster:
 			if c.Stun().IsStunned {
@@ -416,10 +780,10 @@ func (g *Game) processCommands() {
 		}
 	}
 
-	for _, p := range g.Players {
+	for _, p := range g.sortedPlayers() {
 		p.UpdateAttributes()
 	}
-	for _, o := range g.idToObject {
+	for _, o := range g.sortedObjects() {
 		switch m := o.(type) {
 		case *gameobject.Monster:
 			m.UpdateAttributes()
@@ -427,17 +791,28 @@ func (g *Game) processCommands() {
 	}
 
 	// passives
-	for _, p := range g.Players {
+	for _, p := range g.sortedPlayers() {
 		g.processPassives(p)
 	}
-	for _, o := range g.idToObject {
+	for _, o := range g.sortedObjects() {
 		switch m := o.(type) {
 		case *gameobject.Monster:
 			g.processPassives(m)
 		}
 	}
 
-	for pId, c := range g.Commands {
+	for t := range g.commandResults {
+		if t < g.Game.Tick-ChangeHistoryTicks {
+			delete(g.commandResults, t)
+		}
+	}
+	results := map[string]*api.CommandResult{}
+	g.commandResults[g.Game.Tick] = results
+	// commands are executed in a stable order of the issuers
+	issuers := maps.Keys(g.Commands)
+	slices.Sort(issuers)
+	for _, pId := range issuers {
+		c := g.Commands[pId]
 		maybePlayer, err := g.GetObjectById(pId)
 		if err != nil {
 			log.Warn().Err(err).Msg("")
@@ -448,72 +823,53 @@ func (g *Game) processCommands() {
 			log.Warn().Err(err).Msg("object retrieved by ID is not a skiller")
 			continue
 		}
+		result := &api.CommandResult{Tick: g.Game.Tick}
+		results[pId] = result
+		span := g.startCommandsSpan(ctx, pId)
 
 		if c.Yell != nil {
 			err = ExecuteYell(g, skiller, c.Yell)
-			if err != nil {
-				g.LogEvent(&api.Event{
-					Type:        &errorEvent,
-					Message:     fmt.Sprintf("%s (%s): failed to yell: %s", skiller.GetId(), skiller.GetName(), err.Error()),
-					Coordinates: skiller.GetPosition(),
-				})
-			}
+			result.Yell = executionStatus(skiller, "yell", err)
+		}
+
+		// the moves are validated when they are queued, the path is set by the tick so the replay follows the same path
+		if c.Move != nil {
+			err = g.MoveTo(skiller, c.Move)
+			result.Move = executionStatus(skiller, "move", err)
 		}
 
 		if c.Skill != nil {
 			skiller.SetMovingTo(nil)
 			err = ExecuteSkill(g, skiller, c.Skill)
-			if err != nil {
-				g.LogEvent(&api.Event{
-					Type:        &errorEvent,
-					Message:     fmt.Sprintf("%s (%s): failed to use skill: %s", skiller.GetId(), skiller.GetName(), err.Error()),
-					Coordinates: skiller.GetPosition(),
-				})
-			}
+			result.Skill = executionStatus(skiller, "use skill", err)
 		}
 
 		p, ok := maybePlayer.(*gameobject.Player)
 		if !ok {
 			//log.Warn().Err(err).Msg("object retrieved by ID is not a player")
+			g.endCommandsSpan(ctx, span)
 			continue
 		}
 
 		if c.AssignSkillPoints != nil {
 			err = ExecuteAssignSkillPoints(p, c.AssignSkillPoints)
-			if err != nil {
-				g.LogEvent(&api.Event{
-					Type:        &errorEvent,
-					Message:     fmt.Sprintf("%s (%s): failed to assign skill point %s", p.GetId(), p.GetName(), err.Error()),
-					Coordinates: p.GetPosition(),
-				})
-			}
+			result.AssignSkillPoints = executionStatus(p, "assign skill points", err)
 		}
 
 		if c.PickUp != nil {
 			err = ExecutePickUp(g, p, c.PickUp)
-			if err != nil {
-				g.LogEvent(&api.Event{
-					Type:        &errorEvent,
-					Message:     fmt.Sprintf("%s (%s): failed to pick up %s: %s", p.GetId(), p.GetName(), c.PickUp, err.Error()),
-					Coordinates: p.GetPosition(),
-				})
-			}
+			result.PickUp = executionStatus(p, fmt.Sprintf("pick up %s", c.PickUp.Id), err)
 		}
 
 		if c.Buy != nil {
 			err = ExecuteBuy(g, p, c.Buy)
-			if err != nil {
-				g.LogEvent(&api.Event{
-					Type:        &errorEvent,
-					Message:     fmt.Sprintf("%s (%s): failed to buy: %s", p.GetId(), p.GetName(), err.Error()),
-					Coordinates: p.GetPosition(),
-				})
-			}
+			result.Buy = executionStatus(p, "buy", err)
 		}
+		g.endCommandsSpan(ctx, span)
 	}
 
 	// move players based on move to
-	for _, p := range g.Players {
+	for _, p := range g.sortedPlayers() {
 		if p.MovingTo == nil {
 			continue
 		}
@@ -554,7 +910,7 @@ func (g *Game) processCommands() {
 	}
 
 	// move monsters based on move to
-	for _, o := range g.idToObject {
+	for _, o := range g.sortedObjects() {
 		switch m := o.(type) {
 		case *gameobject.Monster:
 			if m.MovingTo == nil {
@@ -578,6 +934,9 @@ func (g *Game) processCommands() {
 	// copy ground effects with zero duration
 	for _, l := range g.Game.Map.Levels {
 		for _, o := range l.Objects {
+			if len(o.Effects) > 0 {
+				g.markChanged(gameobject.PositionToCoordinates(o.Position, l.Level))
+			}
 			for _, e := range o.Effects {
 				for _, m := range o.Monsters {
 					mon, err := g.GetObjectById(m.GetId())
@@ -621,7 +980,7 @@ func (g *Game) processCommands() {
 		}
 	}
 
-	for _, i := range g.idToObject {
+	for _, i := range g.sortedObjects() {
 		switch c := i.(type) {
 		// TODO use only one function
 		case *gameobject.Monster:
@@ -670,7 +1029,7 @@ func (g *Game) processCommands() {
 	}
 
 	// resolve teleports and knockbacks
-	for _, i := range g.idToObject {
+	for _, i := range g.sortedObjects() {
 		switch c := i.(type) {
 		case gameobject.Skiller:
 			if c.GetTeleportTo().Move != nil {
@@ -703,8 +1062,7 @@ func (g *Game) processCommands() {
 	}
 
 	// Kill what is dead
-	// TODO solve kill stats (all players who interacted)
-	for _, i := range g.idToObject {
+	for _, i := range g.sortedObjects() {
 		switch c := i.(type) {
 		case *gameobject.Monster:
 			// move kill count
@@ -720,32 +1078,50 @@ func (g *Game) processCommands() {
 						Coordinates: c.Position,
 					})
 				} else {
+					// the expired summons are not credited
+					var killerId *string
+					var credits []*api.KillCredit
+					if c.Monster.Attributes.Life != nil && *c.Monster.Attributes.Life <= 0 {
+						killerId, credits = g.creditDeath(c)
+					}
 					g.LogEvent(&api.Event{
 						Type:        &deathEvent,
 						Message:     fmt.Sprintf("monster %s (%s) died", c.GetId(), c.Monster.Name),
 						Coordinates: c.Position,
+						VictimId:    pointy.String(c.GetId()),
+						KillerId:    killerId,
+						Credits:     credits,
 					})
 					if c.Monster.Score != nil {
 						g.Score += *c.Monster.Score
+						// the score is split among the credited ones
 						g.LogEvent(&api.Event{
 							Type:        &scoreEvent,
 							Message:     fmt.Sprintf("monster %s (%s) provided %f score", c.GetId(), c.Monster.Name, *c.Monster.Score),
 							Coordinates: c.Position,
+							PlayerId:    killerId,
+							VictimId:    pointy.String(c.GetId()),
+							Score:       c.Monster.Score,
+							Credits:     scoreCredits(credits, *c.Monster.Score),
 						})
 					}
 					RemoveMonsterFromTile(o, c)
+					g.markChanged(c.GetPosition())
 				}
 				po := g.GetMapObjectsOrCreateDefault(c.GetPosition())
+				if len(c.Monster.OnDeath) > 0 {
+					g.markChanged(c.GetPosition())
+				}
 				for _, d := range c.Monster.OnDeath {
 					switch o := d.Data.(type) {
 					case *api.Droppable_Skill:
 						// TODO
 					case *api.Droppable_Item:
-						o.Item.Id = gameobject.GetNewId()
+						o.Item.Id = g.NewId()
 						g.Register(o.Item)
 						po.Items = append(po.Items, o.Item)
 					case *api.Droppable_Monster:
-						o.Monster.Id = gameobject.GetNewId()
+						o.Monster.Id = g.NewId()
 						g.Register(o.Monster)
 						po.Monsters = append(po.Monsters, o.Monster)
 						g.Register(gameobject.CreateMonster(o.Monster, c.GetPosition()))
@@ -760,8 +1136,10 @@ func (g *Game) processCommands() {
 								log.Warn().Err(err).Msg("")
 							}
 							// find door and remove it
-							dp := lc.CacheObjectsOnPosition(gameobject.PositionToCoordinates(door, c.GetPosition().Level), nil)
+							dc := gameobject.PositionToCoordinates(door, c.GetPosition().Level)
+							dp := lc.CacheObjectsOnPosition(dc, nil)
 							if dp != nil {
+								g.markChanged(dc)
 								dp.IsDoor = false
 								dp.IsFree = !dp.IsWall
 								gr := lc.Grid.Get(int(door.PositionX), int(door.PositionY))
@@ -782,18 +1160,47 @@ func (g *Game) processCommands() {
 	}
 
 	g.Commands = map[string]*api.CommandsBatch{}
+	g.commandsTraces = map[string]trace.SpanContext{}
+	g.killers = map[string]string{}
+}
+
+// startCommandsSpan starts the span of the execution of the commands queued by the issuer. The span continues the trace
+// of the request which queued the commands (if it was traced) and links the tick span.
+func (g *Game) startCommandsSpan(ctx context.Context, issuerId string) trace.Span {
+	tickSpan := trace.SpanFromContext(ctx)
+	if sc, ok := g.commandsTraces[issuerId]; ok {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+	}
+	_, span := tracing.Tracer().Start(ctx, "execute commands",
+		trace.WithLinks(trace.Link{SpanContext: tickSpan.SpanContext()}),
+		trace.WithAttributes(attribute.String("issuer", issuerId), attribute.Int("tick", int(g.Game.Tick))))
+	g.span = span
+	return span
+}
+
+// endCommandsSpan ends the span of the commands execution, the following events are recorded to the tick span again.
+func (g *Game) endCommandsSpan(ctx context.Context, span trace.Span) {
+	span.End()
+	g.span = trace.SpanFromContext(ctx)
 }
 
 func (g *Game) GetPlayerByKey(apiKey string) (*gameobject.Player, error) {
-	player, ok := g.ApiKeyToPlayer[apiKey]
+	g.keysLock.RLock()
+	k, err := g.apiKey(apiKey)
+	g.keysLock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	player, ok := g.Players[k.Owner]
 	if !ok {
-		return nil, errors.New("API key is not valid")
+		return nil, apierror.New(api.ErrorCode_INVALID_API_KEY, "API key is not bound to any player")
 	}
 	return player, nil
 }
 
 func (g *Game) GetMoney() int32 {
-	return int32(math.Pow(float64(g.Score)*0.004, 0.75) + float64(420))
+	b := g.balance
+	return int32(math.Pow(float64(g.Score)*b.MoneyScoreFactor, b.MoneyScoreExponent) + b.MoneyBase)
 }
 
 func (g *Game) SpawnPlayer(p *gameobject.Player, level int32) {
@@ -828,7 +1235,8 @@ func (g *Game) removePlayerFromPosition(p *gameobject.Player) {
 	}
 }
 
-func (g *Game) removeItemFromTile(o *api.MapObjects, i *api.Item) {
+func (g *Game) removeItemFromTile(o *api.MapObjects, i *api.Item, level int32) {
+	g.markChanged(gameobject.PositionToCoordinates(o.Position, level))
 	for pi, pd := range o.Items {
 		if pd.Id == i.GetId() {
 			// move last element to removed position
@@ -908,7 +1316,11 @@ func (g *Game) addMonsterToNewPosition(o *api.MapObjects, m *api.Monster, c *api
 // MoveCharacter The coordinates must include level.
 func (g *Game) MoveCharacter(p gameobject.Positioner, c *api.Coordinates) error {
 	equipEvent := api.Event_MOVE
+	if p.GetPosition() == nil || p.GetPosition().Level != c.Level {
+		g.markEnteredLevel(p.GetId(), c.Level)
+	}
 	if p.GetPosition() != nil {
+		g.markChanged(p.GetPosition())
 		switch pt := p.(type) {
 		case *gameobject.Player:
 			// remove player from the previous position
@@ -926,6 +1338,7 @@ func (g *Game) MoveCharacter(p gameobject.Positioner, c *api.Coordinates) error
 	if err != nil {
 		log.Warn().Err(err).Msg("")
 	} else {
+		g.markChanged(c)
 		o := lc.CacheObjectsOnPosition(c, nil)
 		switch pt := p.(type) {
 		case *gameobject.Player:
@@ -948,6 +1361,74 @@ func (g *Game) ForceMoveCharacter(p gameobject.Positioner, c *api.Coordinates) e
 	return nil
 }
 
+// movesTo checks whether the character already moves to the position.
+func movesTo(p gameobject.Alive, c *api.Position) bool {
+	if p.GetMovingTo() == nil {
+		return false
+	}
+	last := p.GetMovingTo().Get(p.GetMovingTo().Length() - 1)
+	return last.X == int(c.PositionX) && last.Y == int(c.PositionY)
+}
+
+// pathTo finds the path of the character to the position.
+func (g *Game) pathTo(p gameobject.Alive, c *api.Position) (*paths.Path, error) {
+	// TODO check if visible
+	// check that path exists
+	lc, err := g.GetCachedLevel(p.GetPosition().Level)
+	if err != nil {
+		return nil, err
+	}
+	if c.PositionX >= lc.Width || c.PositionY >= lc.Height {
+		return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "position (%d, %d) is out of the level map", c.PositionX, c.PositionY)
+	}
+	if p.GetPosition().PositionX >= lc.Width || p.GetPosition().PositionY >= lc.Height {
+		return nil, fmt.Errorf("player position (%d, %d) is out of the level map", p.GetPosition().PositionX, p.GetPosition().PositionY)
+	}
+	if p.GetPosition() == nil {
+		log.Warn().Msgf("trying to move %s which is on nil pos", p.GetId())
+		return nil, fmt.Errorf("tried to move with %s which is on nil pos", p.GetId())
+	}
+	if lc.Grid == nil {
+		log.Warn().Msgf("trying to move on level %d which does not have paths", p.GetPosition().Level)
+		return nil, fmt.Errorf("tried to move on level %d wihthout any paths", p.GetPosition().Level)
+	}
+
+	path := lc.Grid.GetPathFromCells(
+		lc.Grid.Get(int(p.GetPosition().PositionX), int(p.GetPosition().PositionY)),
+		lc.Grid.Get(int(c.PositionX), int(c.PositionY)), false, true)
+	if path == nil {
+		return nil, apierror.New(api.ErrorCode_NO_PATH, "there is no valid path from (%d, %d) to (%d, %d)",
+			p.GetPosition().PositionX, p.GetPosition().PositionY, c.PositionX, c.PositionY)
+	}
+	if path.Length() == 0 {
+		return nil, apierror.New(api.ErrorCode_NO_PATH, "there is no valid path from (%d, %d) to (%d, %d)",
+			p.GetPosition().PositionX, p.GetPosition().PositionY, c.PositionX, c.PositionY)
+	}
+	return path, nil
+}
+
+// ValidateMove checks that the character can move to the position without changing its path.
+func (g *Game) ValidateMove(p gameobject.Alive, c *api.Position) error {
+	if movesTo(p, c) {
+		return nil
+	}
+	_, err := g.pathTo(p, c)
+	return err
+}
+
+// MoveTo sets the path of the character to the position (nothing changes if it already moves there).
+func (g *Game) MoveTo(p gameobject.Alive, c *api.Position) error {
+	if movesTo(p, c) {
+		return nil
+	}
+	path, err := g.pathTo(p, c)
+	if err != nil {
+		return err
+	}
+	p.SetMovingTo(path)
+	return nil
+}
+
 func (g *Game) GetCachedLevel(level int32) (*LevelCache, error) {
 	return g.mapCache.CachedLevel(level)
 }
@@ -1009,6 +1490,18 @@ func (g *Game) GetCommands(pId string) *api.CommandsBatch {
 	return g.Commands[pId]
 }
 
+// SetCommandsTrace remembers the trace of the request which queued the commands so the execution of the commands
+// is part of the same trace.
+func (g *Game) SetCommandsTrace(pId string, ctx context.Context) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	g.CommandsLock.Lock()
+	defer g.CommandsLock.Unlock()
+	g.commandsTraces[pId] = sc
+}
+
 func (g *Game) Register(o gameobject.Ider) {
 	// TODO lock
 	g.idToObject[o.GetId()] = o
@@ -1016,21 +1509,65 @@ func (g *Game) Register(o gameobject.Ider) {
 
 func (g *Game) Unregister(o gameobject.Ider) {
 	delete(g.idToObject, o.GetId())
+	g.markRemoved(o.GetId())
 }
 
 func (g *Game) GetObjectById(id string) (gameobject.Ider, error) {
 	if o, ok := g.idToObject[id]; ok {
 		return o, nil
 	}
-	return nil, fmt.Errorf("object with id %s not found", id)
+	return nil, apierror.New(api.ErrorCode_NOT_FOUND, "object with id %s not found", id).With("id", id)
+}
+
+// Step processes the ticks in the step mode and returns the tick after them. The caller must not hold the GameLock.
+func (g *Game) Step(ctx context.Context, ticks int32) (int32, error) {
+	if !g.Config.Game.StepMode {
+		return 0, apierror.New(api.ErrorCode_NOT_AVAILABLE, "the game is not in the step mode")
+	}
+	if ticks < 0 {
+		return 0, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "number of ticks cannot be negative")
+	}
+	if ticks == 0 {
+		ticks = 1
+	}
+	var tick int32
+	for i := int32(0); i < ticks; i++ {
+		processed := make(chan int32, 1)
+		select {
+		case g.steps <- processed:
+		case <-g.stopping:
+			return tick, apierror.New(api.ErrorCode_NOT_AVAILABLE, "the game is stopping")
+		case <-ctx.Done():
+			return tick, ctx.Err()
+		}
+		// the started tick is always finished
+		tick = <-processed
+	}
+	return tick, nil
 }
 
-func (g *Game) WaitForNextTick(tick int32) {
+// WaitForNextTick blocks until a tick after the given one is processed (or the game loop stops or the context is done).
+func (g *Game) WaitForNextTick(ctx context.Context, tick int32) error {
+	if ctx.Done() != nil {
+		waited := make(chan struct{})
+		defer close(waited)
+		go func() {
+			select {
+			case <-ctx.Done():
+				// wakes up the waiting below
+				g.TickCond.L.Lock()
+				g.TickCond.Broadcast()
+				g.TickCond.L.Unlock()
+			case <-waited:
+			}
+		}()
+	}
 	g.TickCond.L.Lock()
 	defer g.TickCond.L.Unlock()
-	for g.Game.Tick <= tick {
+	for g.Game.Tick <= tick && !g.stopped && ctx.Err() == nil {
 		g.TickCond.Wait()
 	}
+	return ctx.Err()
 }
 
 func HideNonPublicMonsterFields(g *Game, m *api.Monster) {
@@ -0,0 +1,137 @@
+package dungeonsandtrolls
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestCommandsTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	g := NewGame(config.Default())
+	p := gameobject.CreatePlayer(gameobject.GetNewId(), "player 1", config.Default().Balance)
+	p.Character.Stun = &api.Stun{}
+	g.Players[p.GetName()] = p
+	g.Register(p)
+
+	ctx, rpcSpan := otel.Tracer("test").Start(context.Background(), "Yell")
+	g.GetCommands(p.GetId()).Yell = &api.Message{Text: "hello"}
+	g.SetCommandsTrace(p.GetId(), ctx)
+	rpcSpan.End()
+
+	tickCtx, tickSpan := otel.Tracer("test").Start(context.Background(), "tick")
+	g.processCommands(tickCtx)
+	tickSpan.End()
+
+	var executed sdktrace.ReadOnlySpan
+	for _, s := range recorder.Ended() {
+		if s.Name() == "execute commands" {
+			executed = s
+		}
+	}
+	if executed == nil {
+		t.Fatal("execution of the commands was not traced")
+	}
+	if executed.Parent().SpanID() != rpcSpan.SpanContext().SpanID() {
+		t.Fatal("execution should continue the trace of the request")
+	}
+	if len(executed.Links()) != 1 || executed.Links()[0].SpanContext.SpanID() != tickSpan.SpanContext().SpanID() {
+		t.Fatal("execution should link the tick")
+	}
+	if len(executed.Events()) != 1 || executed.Events()[0].Name != api.Event_MESSAGE.String() {
+		t.Fatalf("yell event should be recorded to the execution span: %v", executed.Events())
+	}
+	if len(g.commandsTraces) != 0 {
+		t.Fatal("traces should be reset with the commands")
+	}
+}
+
+func TestStopGameLoop(t *testing.T) {
+	g := NewGame(config.Default())
+	ctx, cancel := context.WithCancel(context.Background())
+	g.stopping = ctx.Done()
+	cancel()
+
+	// the game loop returns before processing another tick
+	g.gameLoop(ctx)
+	select {
+	case <-g.Done():
+	default:
+		t.Fatal("game should be done")
+	}
+	if !g.Stopping() || g.Ready() == nil {
+		t.Fatal("stopped game should not be ready")
+	}
+	// must not block
+	g.WaitForNextTick(context.Background(), g.Game.Tick)
+}
+
+func TestWaitForNextTickCancelled(t *testing.T) {
+	g := NewGame(config.Default())
+	ctx, cancel := context.WithCancel(context.Background())
+	waited := make(chan error)
+	go func() {
+		waited <- g.WaitForNextTick(ctx, g.Game.Tick)
+	}()
+	cancel()
+	select {
+	case err := <-waited:
+		if err != context.Canceled {
+			t.Fatalf("cancellation expected, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("wait should return when the context is cancelled")
+	}
+}
+
+func TestStep(t *testing.T) {
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	c.Game.StepMode = true
+	g := NewGame(c)
+	g.AddParsedLevels(&api.Map{Levels: []*api.Level{{
+		Width:   1,
+		Height:  1,
+		Objects: []*api.MapObjects{{Position: &api.Position{}, IsFree: true}},
+	}}})
+	ctx, cancel := context.WithCancel(context.Background())
+	g.stopping = ctx.Done()
+	go g.gameLoop(ctx)
+	defer func() {
+		cancel()
+		<-g.Done()
+	}()
+
+	tick, err := g.Step(context.Background(), 3)
+	if err != nil || tick != 3 {
+		t.Fatalf("three ticks should be processed, tick %d: %v", tick, err)
+	}
+	waited := make(chan struct{})
+	go func() {
+		g.WaitForNextTick(context.Background(), tick)
+		close(waited)
+	}()
+	select {
+	case <-waited:
+		t.Fatal("tick should not advance without a step")
+	case <-time.After(2 * c.Game.LoopTime):
+	}
+	if tick, err = g.Step(context.Background(), 0); err != nil || tick != 4 {
+		t.Fatalf("one tick should be processed by default, tick %d: %v", tick, err)
+	}
+	<-waited
+
+	if _, err := NewGame(config.Default()).Step(context.Background(), 1); apierror.Code(err) != api.ErrorCode_NOT_AVAILABLE {
+		t.Fatal("step should not be available without the step mode")
+	}
+}
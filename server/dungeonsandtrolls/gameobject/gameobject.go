@@ -21,6 +21,7 @@ type Alive interface {
 	Positioner
 	IsStunned() bool
 	DamageTaken()
+	GetDamageLedger() *DamageLedger
 }
 
 type Skiller interface {
@@ -51,7 +52,7 @@ func TeleportMoveTo(s Skiller, c *api.Coordinates) {
 	}
 }
 
-func Knockback(s Skiller, from *api.Coordinates) {
+func Knockback(s Skiller, from *api.Coordinates, rnd *rand.Rand) {
 	if s.GetTeleportTo().Knockback == nil {
 		s.GetTeleportTo().Knockback = &utils.V{} // null vector
 	}
@@ -60,8 +61,8 @@ func Knockback(s Skiller, from *api.Coordinates) {
 	k := utils.VectorFromPoints(from.PositionX, from.PositionY, to.PositionX, to.PositionY)
 	// random move from self pos
 	if k.X == 0 && k.Y == 0 {
-		k.X += float64(rand.Intn(2) - 1)
-		k.Y += float64(rand.Intn(2) - 1)
+		k.X += float64(rnd.Intn(2) - 1)
+		k.Y += float64(rnd.Intn(2) - 1)
 	}
 	utils.NormalizeVector(k)
 	utils.InverseVector(k)
@@ -0,0 +1,43 @@
+package gameobject
+
+import (
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+)
+
+// DamageLedger keeps the damage taken from the casters in the recent ticks.
+type DamageLedger struct {
+	Records []*api.DamageRecord
+}
+
+// Record adds the damage taken from the caster in the tick and forgets the records older than the window (in ticks).
+func (l *DamageLedger) Record(casterId string, damage float32, tick int32, window int32) {
+	var kept []*api.DamageRecord
+	for _, r := range l.Records {
+		if r.Tick > tick-window {
+			kept = append(kept, r)
+		}
+	}
+	l.Records = kept
+	for _, r := range l.Records {
+		if r.CasterId == casterId && r.Tick == tick {
+			r.Damage += damage
+			return
+		}
+	}
+	l.Records = append(l.Records, &api.DamageRecord{CasterId: casterId, Damage: damage, Tick: tick})
+}
+
+// Since returns the damage taken from the casters since the tick (inclusive) by their IDs.
+func (l *DamageLedger) Since(tick int32) map[string]float32 {
+	damage := map[string]float32{}
+	for _, r := range l.Records {
+		if r.Tick >= tick {
+			damage[r.CasterId] += r.Damage
+		}
+	}
+	return damage
+}
+
+func (l *DamageLedger) Reset() {
+	l.Records = nil
+}
@@ -15,6 +15,9 @@ type Monster struct {
 	Skills       map[string]*api.Skill `json:"-"`
 	TeleportedTo TeleportPosition      `json:"-"`
 	KillCounter  *int32                `json:"-"`
+	// ID of the object which summoned the monster (empty for the generated ones)
+	SummonedBy   string       `json:"-"`
+	DamageLedger DamageLedger `json:"-"`
 }
 
 func CreateMonster(mon *api.Monster, p *api.Coordinates) *Monster {
@@ -38,6 +41,17 @@ func CreateMonster(mon *api.Monster, p *api.Coordinates) *Monster {
 	return m
 }
 
+// RestoreMonster wraps the stored monster, its attributes are not initialized again.
+func RestoreMonster(mon *api.Monster, p *api.Coordinates, killCounter *int32) *Monster {
+	m := &Monster{
+		Position:    p,
+		Monster:     mon,
+		KillCounter: killCounter,
+	}
+	m.generateSkills()
+	return m
+}
+
 func (m *Monster) GetId() string {
 	return m.Monster.Id
 }
@@ -79,6 +93,10 @@ func (m *Monster) GetLastDamageTaken() int32 {
 	return *m.Monster.LastDamageTaken
 }
 
+func (m *Monster) GetDamageLedger() *DamageLedger {
+	return &m.DamageLedger
+}
+
 func (m *Monster) DamageTaken() {
 	m.Monster.LastDamageTaken = pointy.Int32(-1)
 }
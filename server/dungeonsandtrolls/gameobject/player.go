@@ -1,16 +1,17 @@
 package gameobject
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
 	"github.com/solarlune/paths"
 	"go.openly.dev/pointy"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"sort"
 )
 
-const baseStat float32 = 100
-const baseResist float32 = 5
-
 type Player struct {
 	MovingTo       *paths.Path                 `json:"-"`
 	Equipped       map[api.Item_Type]*api.Item `json:"-"`
@@ -19,25 +20,73 @@ type Player struct {
 	ItemAttributes *api.Attributes             `json:"-"`
 	MaxStats       *api.Attributes             `json:"-"`
 	Skills         map[string]*api.Skill       `json:"-"`
-	IsAdmin        bool                        `json:"admin"`
-	TeleportedTo   TeleportPosition            `json:"-"`
+	// Replaced by roles, kept to migrate the stored players.
+	IsAdmin      bool             `json:"admin"`
+	TeleportedTo TeleportPosition `json:"-"`
+	DamageLedger DamageLedger     `json:"-"`
 }
 
-func CreatePlayer(name string) *Player {
+func CreatePlayer(id string, name string, b config.Balance) *Player {
 	p := &Player{
 		Character: &api.Character{
 			Name:            name,
-			Id:              GetNewId(),
+			Id:              id,
 			LastDamageTaken: 10,
 		},
 		Equipped: map[api.Item_Type]*api.Item{},
 	}
-	p.InitAttributes()
+	p.InitAttributes(b)
 	p.UpdateAttributes()
 	return p
 }
 
-func (p *Player) InitAttributes() {
+// RestorePlayer rebuilds the player from the stored character, the equipment and the skills are taken from it.
+func RestorePlayer(c *api.Character, base *api.Attributes) *Player {
+	p := &Player{
+		Character:      c,
+		BaseAttributes: base,
+		ItemAttributes: &api.Attributes{},
+		MaxStats:       c.MaxAttributes,
+		Equipped:       map[api.Item_Type]*api.Item{},
+	}
+	for _, i := range c.Equip {
+		p.Equipped[i.Slot] = i
+		MergeAllAttributes(p.ItemAttributes, i.Attributes, false)
+	}
+	p.generateSkills()
+	return p
+}
+
+// storedPlayer has the character serialized by protojson (encoding/json cannot read the oneof fields of the items).
+type storedPlayer struct {
+	Character json.RawMessage `json:"character"`
+	IsAdmin   bool            `json:"admin"`
+}
+
+func (p *Player) MarshalJSON() ([]byte, error) {
+	c, err := protojson.Marshal(p.Character)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(storedPlayer{Character: c, IsAdmin: p.IsAdmin})
+}
+
+func (p *Player) UnmarshalJSON(b []byte) error {
+	sp := storedPlayer{}
+	if err := json.Unmarshal(b, &sp); err != nil {
+		return err
+	}
+	p.IsAdmin = sp.IsAdmin
+	p.Character = &api.Character{}
+	if len(sp.Character) == 0 {
+		return nil
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(sp.Character, p.Character)
+}
+
+func (p *Player) InitAttributes(b config.Balance) {
+	baseStat := b.BaseStat
+	baseResist := b.BaseResist
 	p.BaseAttributes = &api.Attributes{
 		Life:    pointy.Float32(baseStat * 1.5),
 		Mana:    pointy.Float32(baseStat),
@@ -103,7 +152,7 @@ func (p *Player) UpdateAttributes() error {
 	if err != nil {
 		return err
 	}
-	for _, i := range p.Equipped {
+	for _, i := range p.EquippedItems() {
 		err := MergeAllAttributes(p.ItemAttributes, i.Attributes, false)
 		if err != nil {
 			return err
@@ -185,6 +234,10 @@ func (p *Player) GetLastDamageTaken() int32 {
 	return p.Character.LastDamageTaken
 }
 
+func (p *Player) GetDamageLedger() *DamageLedger {
+	return &p.DamageLedger
+}
+
 func (p *Player) DamageTaken() {
 	p.Character.LastDamageTaken = -1
 }
@@ -219,18 +272,27 @@ func (p *Player) Stun() *api.Stun {
 
 func (p *Player) generateSkills() {
 	p.Skills = map[string]*api.Skill{}
-	for _, i := range p.Equipped {
+	for _, i := range p.EquippedItems() {
 		for _, s := range i.Skills {
 			p.Skills[s.Id] = s
 		}
 	}
 }
 
-func (p *Player) generateEquip() {
-	p.Character.Equip = []*api.Item{}
+// EquippedItems returns the equipped items ordered by the slot (so the attributes are always summed the same way).
+func (p *Player) EquippedItems() []*api.Item {
+	items := make([]*api.Item, 0, len(p.Equipped))
 	for _, item := range p.Equipped {
-		p.Character.Equip = append(p.Character.Equip, item)
+		items = append(items, item)
 	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Slot < items[j].Slot
+	})
+	return items
+}
+
+func (p *Player) generateEquip() {
+	p.Character.Equip = p.EquippedItems()
 	p.generateSkills()
 }
 
@@ -3,6 +3,7 @@ package gameobject
 import (
 	"fmt"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/utils"
 	"math"
 	"math/rand"
@@ -38,16 +39,17 @@ func RoundRange(r float64) float64 {
 	return math.Floor(r)
 }
 
-func RoundSkill(r float64) float64 {
+// RoundSkill rounds up with the probability of the fractional part.
+func RoundSkill(r float64, rnd *rand.Rand) float64 {
 	whole := math.Floor(r)
 	rest := r - whole
-	if rest >= rand.Float64() {
+	if rest >= rnd.Float64() {
 		return math.Ceil(r)
 	}
 	return whole
 }
 
-func EvaluateDamage(power float64, t api.DamageType, a *api.Attributes) float32 {
+func EvaluateDamage(power float64, t api.DamageType, a *api.Attributes, b config.Balance, rnd *rand.Rand) float32 {
 	var resist float64
 	switch t {
 	case api.DamageType_slash:
@@ -71,7 +73,7 @@ func EvaluateDamage(power float64, t api.DamageType, a *api.Attributes) float32
 			resist = float64(*a.ElectricResist)
 		}
 	}
-	damage := float32(RoundSkill(power) * 10 / (10 + utils.Max(resist, -5)))
+	damage := float32(RoundSkill(power, rnd) * b.ResistConstant / (b.ResistConstant + utils.Max(resist, b.MinResist)))
 	*a.Life -= damage
 	return damage
 }
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/keydelivery"
+)
+
+// RotateApiKey delivers a new key to the player, the old key stops working once the new one is delivered.
+func RotateApiKey(game *dungeonsandtrolls.Game, delivery keydelivery.KeyDelivery, token string) (*api.Registration, error) {
+	p, err := game.GetPlayerByKey(token)
+	if err != nil {
+		return nil, err
+	}
+	newKey := dungeonsandtrolls.GenerateApiKey()
+	r, err := deliverApiKey(delivery, newKey, p.GetName())
+	if err != nil {
+		return nil, err
+	}
+	err = game.ReplaceApiKey(token, newKey)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// IssueApiKey returns the new key directly (the caller already owns a key).
+func IssueApiKey(game *dungeonsandtrolls.Game, r *api.ApiKeyRequest, token string) (*api.Registration, error) {
+	roles, err := auth.ParseRoles(r.Roles)
+	if err != nil {
+		return nil, err
+	}
+	newKey, err := game.IssueApiKey(token, roles, r.GetLabel())
+	if err != nil {
+		return nil, err
+	}
+	return &api.Registration{ApiKey: &newKey}, nil
+}
@@ -1,9 +1,10 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
 )
 
@@ -11,53 +12,53 @@ func validateAssignAttributes(p *gameobject.Player, a *api.Attributes) error {
 	// TODO should this be int?
 
 	if p.Stun().IsStunned {
-		return fmt.Errorf("you are stunned")
+		return apierror.New(api.ErrorCode_STUNNED, "you are stunned")
 	}
 
 	if a.Strength != nil && *a.Strength < 0 {
-		return fmt.Errorf("strength is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "strength is <0")
 	}
 	if a.Dexterity != nil && *a.Dexterity < 0 {
-		return fmt.Errorf("dexterity is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "dexterity is <0")
 	}
 	if a.Intelligence != nil && *a.Intelligence < 0 {
-		return fmt.Errorf("intelligence is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "intelligence is <0")
 	}
 	if a.Willpower != nil && *a.Willpower < 0 {
-		return fmt.Errorf("willpower is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "willpower is <0")
 	}
 	if a.Constitution != nil && *a.Constitution < 0 {
-		return fmt.Errorf("constitution is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "constitution is <0")
 	}
 	if a.SlashResist != nil && *a.SlashResist < 0 {
-		return fmt.Errorf("slashResist is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "slashResist is <0")
 	}
 	if a.PierceResist != nil && *a.PierceResist < 0 {
-		return fmt.Errorf("pierceResist is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "pierceResist is <0")
 	}
 	if a.FireResist != nil && *a.FireResist < 0 {
-		return fmt.Errorf("fireResist is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "fireResist is <0")
 	}
 	if a.PoisonResist != nil && *a.PoisonResist < 0 {
-		return fmt.Errorf("poisonResist is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "poisonResist is <0")
 	}
 	if a.ElectricResist != nil && *a.ElectricResist < 0 {
-		return fmt.Errorf("electricResist is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "electricResist is <0")
 	}
 	if a.Life != nil && *a.Life < 0 {
-		return fmt.Errorf("life is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "life is <0")
 	}
 	if a.Stamina != nil && *a.Stamina < 0 {
-		return fmt.Errorf("stamina is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "stamina is <0")
 	}
 	if a.Mana != nil && *a.Mana < 0 {
-		return fmt.Errorf("mana is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "mana is <0")
 	}
 	if a.Constant != nil && *a.Constant < 0 {
-		return fmt.Errorf("scalar is <0")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "scalar is <0")
 	}
 	if a.Constant != nil && *a.Constant != 0 {
-		return fmt.Errorf("scalar cannot be changed")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "scalar cannot be changed")
 	}
 
 	sum, err := gameobject.SumAttributes(a)
@@ -65,28 +66,30 @@ func validateAssignAttributes(p *gameobject.Player, a *api.Attributes) error {
 		return err
 	}
 	if sum > float32(p.Character.SkillPoints) {
-		return fmt.Errorf("not enough skill points %f > %f", sum, p.Character.SkillPoints)
+		return apierror.New(api.ErrorCode_INSUFFICIENT_SKILL_POINTS, "not enough skill points %f > %f", sum, p.Character.SkillPoints).
+			With("required", sum).
+			With("available", p.Character.SkillPoints)
 	}
 
 	return nil
 }
 
-func AssignAttributes(game *dungeonsandtrolls.Game, a *api.Attributes, token string) error {
-	p, err := game.GetCurrentPlayer(token)
+func AssignAttributes(ctx context.Context, game *dungeonsandtrolls.Game, a *api.Attributes, token string) (*api.CommandResult, error) {
+	p, err := commandIssuer(game, token)
 	if err != nil {
-		return err
-	}
-	if p.IsAdmin {
-		return fmt.Errorf("admin players are are not allowed to call non-monster commands")
+		return nil, err
 	}
 
-	err = validateAssignAttributes(p, a)
+	err = traced(ctx, "validateAssignAttributes", func() error { return validateAssignAttributes(p, a) })
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pc := game.GetCommands(p.Character.Id)
 	pc.AssignSkillPoints = a
+	commandsAccepted(ctx, game, p.Character.Id)
 
-	return nil
+	result := newCommandResult(game)
+	result.AssignSkillPoints = validationStatus(nil)
+	return result, nil
 }
@@ -1,33 +1,34 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
 )
 
-func Buy(game *dungeonsandtrolls.Game, identifiers *api.Identifiers, token string) error {
-	p, err := game.GetCurrentPlayer(token)
+func Buy(ctx context.Context, game *dungeonsandtrolls.Game, identifiers *api.Identifiers, token string) (*api.CommandResult, error) {
+	p, err := commandIssuer(game, token)
 	if err != nil {
-		return err
-	}
-	if p.IsAdmin {
-		return fmt.Errorf("admin players are are not allowed to call non-monster commands")
+		return nil, err
 	}
 
 	if p.GetPosition().Level != gameobject.ZeroLevel {
-		return fmt.Errorf("buying is available only on the ground floor")
+		return nil, apierror.New(api.ErrorCode_NOT_AVAILABLE, "buying is available only on the ground floor")
 	}
 
-	err = dungeonsandtrolls.ValidateBuy(game, p, identifiers)
+	err = traced(ctx, "validateBuy", func() error { return dungeonsandtrolls.ValidateBuy(game, p, identifiers) })
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pc := game.GetCommands(p.Character.Id)
 	// TODO per player lock
 	pc.Buy = identifiers
+	commandsAccepted(ctx, game, p.Character.Id)
 
-	return nil
+	result := newCommandResult(game)
+	result.Buy = validationStatus(nil)
+	return result, nil
 }
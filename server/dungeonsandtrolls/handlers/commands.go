@@ -1,71 +1,117 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/tracing"
+	"go.openly.dev/pointy"
 )
 
-func Commands(game *dungeonsandtrolls.Game, c *api.CommandsBatch, token string) error {
-	p, err := game.GetCurrentPlayer(token)
-	if err != nil {
-		return err
+// validationStatus converts the outcome of the command validation to the status reported to the issuer.
+func validationStatus(err error) *api.CommandStatus {
+	if err == nil {
+		return &api.CommandStatus{Status: api.CommandStatus_ACCEPTED}
+	}
+	return &api.CommandStatus{
+		Status:    api.CommandStatus_REJECTED,
+		ErrorCode: apierror.Code(err),
+		Message:   pointy.String(err.Error()),
 	}
+}
 
-	if p.Stun().IsStunned {
-		return fmt.Errorf("you are stunned")
+// traced runs the validation in a child span of the request.
+func traced(ctx context.Context, name string, validate func() error) error {
+	_, span := tracing.Tracer().Start(ctx, name)
+	err := validate()
+	tracing.End(span, err)
+	return err
+}
+
+// commandIssuer returns the player issuing a command, no commands are accepted while the game is stopping.
+func commandIssuer(game *dungeonsandtrolls.Game, token string) (*gameobject.Player, error) {
+	if game.Stopping() {
+		return nil, apierror.New(api.ErrorCode_NOT_AVAILABLE, "the game is shutting down")
+	}
+	return game.GetCurrentPlayer(token)
+}
+
+// commandsAccepted remembers the trace of the request which queued the commands and reports the commands of the
+// character as submitted (to the lockstep waiting for them).
+func commandsAccepted(ctx context.Context, game *dungeonsandtrolls.Game, id string) {
+	game.SetCommandsTrace(id, ctx)
+	game.CommandsSubmitted(id)
+}
+
+func newCommandResult(game *dungeonsandtrolls.Game) *api.CommandResult {
+	return &api.CommandResult{Tick: game.Game.Tick}
+}
+
+// Commands validates every command of the batch separately, the valid ones are queued (replacing the previously
+// queued commands) and the invalid ones are reported in the result.
+func Commands(ctx context.Context, game *dungeonsandtrolls.Game, c *api.CommandsBatch, token string) (*api.CommandResult, error) {
+	p, err := commandIssuer(game, token)
+	if err != nil {
+		return nil, err
 	}
 
-	if p.IsAdmin {
-		return fmt.Errorf("admin players are are not allowed to call non-monster commands")
+	if p.Stun().IsStunned {
+		return nil, apierror.New(api.ErrorCode_STUNNED, "you are stunned")
 	}
 
+	result := newCommandResult(game)
+	queued := &api.CommandsBatch{}
 	if c.Buy != nil {
-		err = dungeonsandtrolls.ValidateBuy(game, p, c.Buy)
-		if err != nil {
-			return err
+		err = traced(ctx, "validateBuy", func() error { return dungeonsandtrolls.ValidateBuy(game, p, c.Buy) })
+		result.Buy = validationStatus(err)
+		if err == nil {
+			queued.Buy = c.Buy
 		}
 	}
 	if c.Yell != nil {
-		err = validateYell(game, c.Yell, p)
-		if err != nil {
-			return err
+		err = traced(ctx, "validateYell", func() error { return validateYell(c.Yell, game.Config.Game.PlayerYellLimit) })
+		result.Yell = validationStatus(err)
+		if err == nil {
+			queued.Yell = c.Yell
 		}
 	}
 	if c.PickUp != nil {
-		err = validatePickUp(game, c.PickUp, p)
-		if err != nil {
-			return err
+		err = traced(ctx, "validatePickUp", func() error { return validatePickUp(game, c.PickUp, p) })
+		result.PickUp = validationStatus(err)
+		if err == nil {
+			queued.PickUp = c.PickUp
 		}
 	}
 	if c.Skill != nil {
-		err = validateSkill(game, c.Skill, p)
-		if err != nil {
-			return err
+		err = traced(ctx, "validateSkill", func() error { return validateSkill(game, c.Skill, p) })
+		result.Skill = validationStatus(err)
+		if err == nil {
+			queued.Skill = c.Skill
 		}
 	}
 	if c.AssignSkillPoints != nil {
-		err = validateAssignAttributes(p, c.AssignSkillPoints)
-		if err != nil {
-			return err
+		err = traced(ctx, "validateAssignAttributes", func() error { return validateAssignAttributes(p, c.AssignSkillPoints) })
+		result.AssignSkillPoints = validationStatus(err)
+		if err == nil {
+			queued.AssignSkillPoints = c.AssignSkillPoints
 		}
 	}
 	// TODO player lock
 	if c.Move != nil {
-		err = validateAndSetMove(game, c.Move, p)
-		if err != nil {
-			return err
-		}
+		result.Move = validationStatus(traced(ctx, "validateAndSetMove", func() error { return validateAndSetMove(game, c.Move, p) }))
 	}
 
 	pc := game.GetCommands(p.Character.Id)
 	game.CommandsLock.Lock()
-	pc.Yell = c.Yell
-	pc.Buy = c.Buy
-	pc.PickUp = c.PickUp
-	pc.Skill = c.Skill
-	pc.AssignSkillPoints = c.AssignSkillPoints
+	pc.Yell = queued.Yell
+	pc.Buy = queued.Buy
+	pc.PickUp = queued.PickUp
+	pc.Skill = queued.Skill
+	pc.AssignSkillPoints = queued.AssignSkillPoints
 	game.CommandsLock.Unlock()
+	commandsAccepted(ctx, game, p.Character.Id)
 
-	return nil
+	return result, nil
 }
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"go.openly.dev/pointy"
+	"testing"
+)
+
+func TestCommandsPartiallyAccepted(t *testing.T) {
+	game := dungeonsandtrolls.NewGame(config.Default())
+	p := gameobject.CreatePlayer(gameobject.GetNewId(), "player 1", config.Default().Balance)
+	p.Character.Stun = &api.Stun{}
+	game.Players[p.GetName()] = p
+	game.AddApiKey("key", p.GetName(), []auth.Role{auth.RolePlayer}, "")
+
+	result, err := Commands(context.Background(), game, &api.CommandsBatch{
+		Yell:              &api.Message{Text: "hello"},
+		AssignSkillPoints: &api.Attributes{Strength: pointy.Float32(5)},
+	}, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Yell.Status != api.CommandStatus_ACCEPTED {
+		t.Fatalf("yell was not accepted: %v", result.Yell)
+	}
+	if result.AssignSkillPoints.Status != api.CommandStatus_REJECTED || result.AssignSkillPoints.ErrorCode != api.ErrorCode_INSUFFICIENT_SKILL_POINTS {
+		t.Fatalf("assigning skill points was not rejected: %v", result.AssignSkillPoints)
+	}
+	if result.Skill != nil {
+		t.Fatal("status of a command which was not sent")
+	}
+	pc := game.GetCommands(p.GetId())
+	if pc.Yell == nil || pc.AssignSkillPoints != nil {
+		t.Fatalf("wrong commands queued: %v", pc)
+	}
+}
@@ -1,46 +1,43 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
 )
 
-func validateMonsterCommands(game *dungeonsandtrolls.Game, mc *api.CommandsForMonsters, p *gameobject.Player) error {
-	if !p.IsAdmin {
-		return fmt.Errorf("you need to be a monster puppeteer (admin) to do this")
-	}
-
+func validateMonsterCommands(ctx context.Context, game *dungeonsandtrolls.Game, mc *api.CommandsForMonsters) error {
 	for mId, c := range mc.Commands {
 		o, err := game.GetObjectById(mId)
 		if err != nil {
-			return fmt.Errorf("tried to control monster with ID %s which does not exist", mId)
+			return apierror.New(api.ErrorCode_NOT_FOUND, "tried to control monster with ID %s which does not exist", mId)
 		}
 		m, ok := o.(*gameobject.Monster)
-		if m.Stun().IsStunned {
-			return fmt.Errorf("tried to control stunned monster")
-		}
 		if !ok {
-			return fmt.Errorf("tried to control %s which is not a monster", mId)
+			return apierror.New(api.ErrorCode_INVALID_TARGET, "tried to control %s which is not a monster", mId)
+		}
+		if m.Stun().IsStunned {
+			return apierror.New(api.ErrorCode_STUNNED, "tried to control stunned monster")
 		}
 		if c.Buy != nil {
-			return fmt.Errorf("monsters are not allowed to shop")
+			return apierror.New(api.ErrorCode_NOT_AVAILABLE, "monsters are not allowed to shop")
 		}
 		if c.PickUp != nil {
-			return fmt.Errorf("monsters are not allowed to pick up")
+			return apierror.New(api.ErrorCode_NOT_AVAILABLE, "monsters are not allowed to pick up")
 		}
 		if c.AssignSkillPoints != nil {
-			return fmt.Errorf("monsters are not allowed to shop")
+			return apierror.New(api.ErrorCode_NOT_AVAILABLE, "monsters are not allowed to shop")
 		}
 		if c.Yell != nil {
-			err = validateYell(game, c.Yell, p)
+			err = validateYell(c.Yell, game.Config.Game.MonsterYellLimit)
 			if err != nil {
 				return err
 			}
 		}
 		if c.Skill != nil && c.Move != nil {
-			return fmt.Errorf("cannot use skill and move at the same time")
+			return apierror.New(api.ErrorCode_CONFLICTING_COMMANDS, "cannot use skill and move at the same time")
 		}
 		if c.Skill != nil {
 			err = validateSkill(game, c.Skill, m)
@@ -61,20 +58,24 @@ func validateMonsterCommands(game *dungeonsandtrolls.Game, mc *api.CommandsForMo
 		pc.Yell = c.Yell
 		pc.Skill = c.Skill
 		game.CommandsLock.Unlock()
+		commandsAccepted(ctx, game, mId)
 	}
 	return nil
 }
 
-func MonsterCommands(game *dungeonsandtrolls.Game, b *api.CommandsForMonsters, token string) error {
-	p, err := game.GetCurrentPlayer(token)
-	if err != nil {
-		return err
+func MonsterCommands(ctx context.Context, game *dungeonsandtrolls.Game, b *api.CommandsForMonsters, token string) error {
+	// the caller is allowed to control monsters based on its role (checked by the auth interceptor)
+	if game.Stopping() {
+		return apierror.New(api.ErrorCode_NOT_AVAILABLE, "the game is shutting down")
 	}
-
-	err = validateMonsterCommands(game, b, p)
+	err := traced(ctx, "validateMonsterCommands", func() error { return validateMonsterCommands(ctx, game, b) })
 	if err != nil {
 		return err
 	}
+	// the commands of a puppeteer owning a character count as its submission
+	if p, err := game.GetCurrentPlayer(token); err == nil {
+		game.CommandsSubmitted(p.GetId())
+	}
 
 	return nil
 }
@@ -1,16 +1,17 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
 	"google.golang.org/protobuf/proto"
 )
 
 func validatePickUp(game *dungeonsandtrolls.Game, i *api.Identifier, p *gameobject.Player) error {
 	if p.Stun().IsStunned {
-		return fmt.Errorf("you are stunned")
+		return apierror.New(api.ErrorCode_STUNNED, "you are stunned")
 	}
 
 	// TODO maybe buyValidation could be used if the price is 0?
@@ -20,7 +21,7 @@ func validatePickUp(game *dungeonsandtrolls.Game, i *api.Identifier, p *gameobje
 		return err
 	}
 	if o == nil {
-		return fmt.Errorf("there are no objects in the pickup location (player posistion)")
+		return apierror.New(api.ErrorCode_NOT_FOUND, "there are no objects in the pickup location (player posistion)")
 	}
 	var item *api.Item
 	for _, it := range o.Items {
@@ -29,7 +30,7 @@ func validatePickUp(game *dungeonsandtrolls.Game, i *api.Identifier, p *gameobje
 		}
 	}
 	if item == nil {
-		return fmt.Errorf("there are is no item %s in pickup location (player posistion)", i.Id)
+		return apierror.New(api.ErrorCode_NOT_FOUND, "there are is no item %s in pickup location (player posistion)", i.Id)
 	}
 
 	// check requirements
@@ -50,7 +51,7 @@ func validatePickUp(game *dungeonsandtrolls.Game, i *api.Identifier, p *gameobje
 		return err
 	}
 	if !s {
-		return fmt.Errorf("requirements not satisfied")
+		return apierror.New(api.ErrorCode_REQUIREMENTS_NOT_MET, "requirements not satisfied")
 	}
 
 	// check that requirements for all other items are still satisfied after the swap
@@ -63,29 +64,29 @@ func validatePickUp(game *dungeonsandtrolls.Game, i *api.Identifier, p *gameobje
 			return err
 		}
 		if !s {
-			return fmt.Errorf("requirements not satisfied")
+			return apierror.New(api.ErrorCode_REQUIREMENTS_NOT_MET, "requirements not satisfied")
 		}
 	}
 
 	return nil
 }
 
-func PickUp(game *dungeonsandtrolls.Game, i *api.Identifier, token string) error {
-	p, err := game.GetCurrentPlayer(token)
+func PickUp(ctx context.Context, game *dungeonsandtrolls.Game, i *api.Identifier, token string) (*api.CommandResult, error) {
+	p, err := commandIssuer(game, token)
 	if err != nil {
-		return err
-	}
-	if p.IsAdmin {
-		return fmt.Errorf("admin players are are not allowed to call non-monster commands")
+		return nil, err
 	}
 
-	err = validatePickUp(game, i, p)
+	err = traced(ctx, "validatePickUp", func() error { return validatePickUp(game, i, p) })
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pc := game.GetCommands(p.Character.Id)
 	pc.PickUp = i
+	commandsAccepted(ctx, game, p.Character.Id)
 
-	return nil
+	result := newCommandResult(game)
+	result.PickUp = validationStatus(nil)
+	return result, nil
 }
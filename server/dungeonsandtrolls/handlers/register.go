@@ -1,50 +1,66 @@
 package handlers
 
 import (
-	"errors"
-	"fmt"
-
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/discord"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/keydelivery"
 	"github.com/rs/zerolog/log"
 )
 
 func validateRegistration(game *dungeonsandtrolls.Game, username string) error {
 	if len(username) == 0 {
-		return errors.New("username not provided")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "username not provided")
+	}
+
+	if game.IsBanned(username) {
+		return apierror.New(api.ErrorCode_PERMISSION_DENIED, "username is banned")
 	}
 
 	if _, ok := game.Players[username]; ok {
-		return errors.New("username is already used")
+		return apierror.New(api.ErrorCode_ALREADY_EXISTS, "username is already used")
 	}
 	return nil
 }
 
-func generateApiKey() string {
-	return gameobject.GetNewId()
+func deliverApiKey(delivery keydelivery.KeyDelivery, apiKey string, handle string) (*api.Registration, error) {
+	r, err := delivery.Deliver(apiKey, handle)
+	if err != nil {
+		log.Warn().Err(err).Msgf("failed to deliver api key to %s", handle)
+		if apierror.Code(err) == api.ErrorCode_UNKNOWN_ERROR {
+			err = apierror.New(api.ErrorCode_NOT_AVAILABLE, "failed to deliver api key to %s", handle)
+		}
+		return nil, err
+	}
+	return r, nil
 }
 
-func RegisterUser(game *dungeonsandtrolls.Game, user *api.User) (*api.Registration, error) {
-	// TODO
-	//game.GameLock.Lock()
-	//defer game.GameLock.Unlock()
+func RegisterUser(game *dungeonsandtrolls.Game, delivery keydelivery.KeyDelivery, user *api.User) (*api.Registration, error) {
 	userHandle, _, _ := discord.ParseUsernameAndDiscriminatorFromHandle(user.Username)
+	game.GameLock.RLock()
 	err := validateRegistration(game, userHandle)
+	game.GameLock.RUnlock()
 	if err != nil {
 		return nil, err
 	}
-	apiKey := generateApiKey()
-	err = discord.SendAPIKeyToUser(apiKey, user.Username)
+	// the key is delivered without the lock so the slow deliveries do not hold the ticks
+	apiKey := dungeonsandtrolls.GenerateApiKey()
+	r, err := deliverApiKey(delivery, apiKey, user.Username)
 	if err != nil {
-		log.Warn().Err(err).Msgf("failed to send api key to %s, Discord used probably does not exist", user.Username)
-		return nil, fmt.Errorf("failed to send api key to %s, Discord used probably does not exist", userHandle)
+		return nil, err
 	}
-	r := &api.Registration{
-		ApiKey: &apiKey,
+
+	// the player is added between the ticks (and recorded for the tick it is added before)
+	game.GameLock.Lock()
+	defer game.GameLock.Unlock()
+	// the same name may have been registered during the delivery
+	if err := validateRegistration(game, userHandle); err != nil {
+		return nil, err
 	}
-	game.AddPlayer(gameobject.CreatePlayer(userHandle), r)
-	r.ApiKey = nil
+	game.AddPlayer(gameobject.CreatePlayer(game.NewId(), userHandle, game.Balance()))
+	game.AddApiKey(apiKey, userHandle, []auth.Role{auth.RolePlayer}, "")
 	return r, nil
 }
@@ -1,14 +1,20 @@
 package handlers
 
 import (
+	"fmt"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/keydelivery"
 	"testing"
 )
 
 func TestRegistration(t *testing.T) {
-	game := dungeonsandtrolls.NewGame()
-	game.Players["player 1"] = gameobject.CreatePlayer("player 1")
+	game := dungeonsandtrolls.NewGame(config.Default())
+	game.Players["player 1"] = gameobject.CreatePlayer(gameobject.GetNewId(), "player 1", config.Default().Balance)
 	if validateRegistration(game, "") == nil {
 		t.Fatal("empty user allowed")
 	}
@@ -19,3 +25,44 @@ func TestRegistration(t *testing.T) {
 		t.Fatal("registration failed")
 	}
 }
+
+func TestRegisterUser(t *testing.T) {
+	game := dungeonsandtrolls.NewGame(config.Default())
+	spawn := true
+	game.AddParsedLevels(&api.Map{Levels: []*api.Level{{
+		Width:   1,
+		Height:  1,
+		Objects: []*api.MapObjects{{Position: &api.Position{}, IsFree: true, IsSpawn: &spawn}},
+	}}})
+	delivery := &keydelivery.Fake{}
+	r, err := RegisterUser(game, delivery, &api.User{Username: "player"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.ApiKey != nil {
+		t.Fatal("api key should not be returned")
+	}
+	key, ok := delivery.Keys["player"]
+	if !ok {
+		t.Fatal("api key was not delivered")
+	}
+	p, err := game.GetPlayerByKey(key)
+	if err != nil || p.Character.Name != "player" {
+		t.Fatal("player was not registered with the delivered key")
+	}
+	roles, err := game.Roles(key)
+	if err != nil || !auth.HasRole(roles, auth.RolePlayer) {
+		t.Fatal("player role was not assigned")
+	}
+
+	if _, err := RegisterUser(game, delivery, &api.User{Username: "player"}); apierror.Code(err) != api.ErrorCode_ALREADY_EXISTS {
+		t.Fatal("existing user registered again")
+	}
+	delivery.Err = fmt.Errorf("delivery failed")
+	if _, err := RegisterUser(game, delivery, &api.User{Username: "player 2"}); apierror.Code(err) != api.ErrorCode_NOT_AVAILABLE {
+		t.Fatal("failed delivery should fail the registration")
+	}
+	if _, ok := game.Players["player 2"]; ok {
+		t.Fatal("player added despite failed delivery")
+	}
+}
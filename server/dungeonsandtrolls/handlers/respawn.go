@@ -1,18 +1,14 @@
 package handlers
 
 import (
-	"fmt"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 )
 
 func Respawn(game *dungeonsandtrolls.Game, token string) error {
-	p, err := game.GetCurrentPlayer(token)
+	p, err := commandIssuer(game, token)
 	if err != nil {
 		return err
 	}
-	if p.IsAdmin {
-		return fmt.Errorf("admin players are are not allowed to call non-monster commands")
-	}
 
 	game.Respawns = append(game.Respawns, p)
 
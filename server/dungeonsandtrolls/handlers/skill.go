@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/utils"
 )
@@ -16,38 +18,39 @@ func checkDistance(playerPosition *api.Coordinates, playerAttributes *api.Attrib
 	}
 	distanceValue = gameobject.RoundRange(distanceValue)
 	if float64(distance) > distanceValue {
-		return fmt.Errorf("cast location is too far away %d > %f", distance, distanceValue)
+		return apierror.New(api.ErrorCode_OUT_OF_RANGE, "cast location is too far away %d > %f", distance, distanceValue).
+			With("distance", distance).
+			With("range", distanceValue)
 	}
 	return nil
 }
 
 func validateSkill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, p gameobject.Skiller) error {
-	p.SetMovingTo(nil)
 	if p.IsStunned() {
-		return fmt.Errorf("you are stunned")
+		return apierror.New(api.ErrorCode_STUNNED, "you are stunned")
 	}
 
 	s, ok := p.GetSkill(skillUse.SkillId)
 	if !ok {
-		return fmt.Errorf("skill %s not found for Character %s", skillUse.SkillId, p.GetId())
+		return apierror.New(api.ErrorCode_NOT_FOUND, "skill %s not found for Character %s", skillUse.SkillId, p.GetId())
 	}
 
 	if skillUse.TargetId != nil && skillUse.Position != nil {
-		return fmt.Errorf("cannot use skill on target and location at the same time")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "cannot use skill on target and location at the same time")
 	}
 	if skillUse.TargetId == nil && (s.Target == api.Skill_character) {
-		return fmt.Errorf("skill targetId not specified")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "skill targetId not specified")
 	}
 	if (skillUse.TargetId != nil || skillUse.Position != nil) && (s.Target == api.Skill_none) {
-		return fmt.Errorf("skill target should be none")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "skill target should be none")
 	}
 	if skillUse.Position == nil && s.Target == api.Skill_position {
-		return fmt.Errorf("skill location not specified")
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "skill location not specified")
 	}
 
 	if s.Flags != nil {
 		if s.Flags.Passive {
-			return fmt.Errorf("passive skills cannot be used (they are used automatically)")
+			return apierror.New(api.ErrorCode_NOT_AVAILABLE, "passive skills cannot be used (they are used automatically)")
 		}
 
 		if s.Flags.RequiresLineOfSight {
@@ -56,11 +59,11 @@ func validateSkill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, p gameo
 			case api.Skill_character:
 				ti, err := game.GetObjectById(*skillUse.TargetId)
 				if err != nil {
-					return fmt.Errorf("targetId %s is not valid", *skillUse.TargetId)
+					return apierror.New(api.ErrorCode_NOT_FOUND, "targetId %s is not valid", *skillUse.TargetId)
 				}
 				t, ok := ti.(gameobject.Skiller)
 				if !ok {
-					return fmt.Errorf("using skill on wrong object type with id %s", *skillUse.TargetId)
+					return apierror.New(api.ErrorCode_INVALID_TARGET, "using skill on wrong object type with id %s", *skillUse.TargetId)
 				}
 				targetPos = gameobject.PlainPosFromApiPos(gameobject.CoordinatesToPosition(t.GetPosition()))
 			case api.Skill_position:
@@ -86,12 +89,12 @@ func validateSkill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, p gameo
 			}
 
 			if !gameobject.GetLoS(currentLevel, resultMap, map[float32]float32{}, gameobject.CoordinatesToPosition(p.GetPosition()), targetPos) {
-				return fmt.Errorf("target is not in line of sight")
+				return apierror.New(api.ErrorCode_NO_LINE_OF_SIGHT, "target is not in line of sight")
 			}
 		}
 		if s.Flags.RequiresOutOfCombat {
-			if p.GetLastDamageTaken() < 3 {
-				return fmt.Errorf("cannot use this skill, you have taken damage recently (out of combat flag)")
+			if p.GetLastDamageTaken() < game.Balance().OutOfCombatTicks {
+				return apierror.New(api.ErrorCode_IN_COMBAT, "cannot use this skill, you have taken damage recently (out of combat flag)")
 			}
 		}
 	}
@@ -102,26 +105,26 @@ func validateSkill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, p gameo
 			return err
 		}
 		if !satisfied {
-			return fmt.Errorf("requirements (cost) for the skill are not satisfied")
+			return apierror.New(api.ErrorCode_REQUIREMENTS_NOT_MET, "requirements (cost) for the skill are not satisfied")
 		}
 	}
 
 	if skillUse.TargetId != nil {
 		t, err := game.GetObjectById(*skillUse.TargetId)
 		if err != nil {
-			return fmt.Errorf("targetId %s is not valid", *skillUse.TargetId)
+			return apierror.New(api.ErrorCode_NOT_FOUND, "targetId %s is not valid", *skillUse.TargetId)
 		}
 		switch v := t.(type) {
 		case gameobject.Skiller:
 			if s.Target != api.Skill_character {
-				return fmt.Errorf("the skill %s is not supposed to be used on characters", skillUse.SkillId)
+				return apierror.New(api.ErrorCode_INVALID_TARGET, "the skill %s is not supposed to be used on characters", skillUse.SkillId)
 			}
 			err = checkDistance(p.GetPosition(), p.GetAttributes(), v.GetPosition(), s)
 			if err != nil {
 				return err
 			}
 		default:
-			return fmt.Errorf("using skill on wrong object type with id %s", *skillUse.TargetId)
+			return apierror.New(api.ErrorCode_INVALID_TARGET, "using skill on wrong object type with id %s", *skillUse.TargetId)
 		}
 	}
 	if skillUse.Position != nil {
@@ -130,7 +133,7 @@ func validateSkill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, p gameo
 			return fmt.Errorf("level not found")
 		}
 		if skillUse.Position.PositionX >= l.Width && skillUse.Position.PositionY >= l.Height {
-			return fmt.Errorf("skill target position (%d, %d) not found in the level", skillUse.Position.PositionX, skillUse.Position.PositionY)
+			return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "skill target position (%d, %d) not found in the level", skillUse.Position.PositionX, skillUse.Position.PositionY)
 		}
 		err = checkDistance(p.GetPosition(), p.GetAttributes(), gameobject.PositionToCoordinates(skillUse.Position, p.GetPosition().Level), s)
 		if s.CasterEffects.Flags.Movement {
@@ -139,7 +142,7 @@ func validateSkill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, p gameo
 				return fmt.Errorf("an error occured during movement %s", err.Error())
 			}
 			if pos != nil && !pos.IsFree {
-				return fmt.Errorf("move postion (%d, %d) is not free", skillUse.Position.PositionX, skillUse.Position.PositionY)
+				return apierror.New(api.ErrorCode_INVALID_TARGET, "move postion (%d, %d) is not free", skillUse.Position.PositionX, skillUse.Position.PositionY)
 			}
 		}
 		if err != nil {
@@ -150,22 +153,22 @@ func validateSkill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, p gameo
 	return nil
 }
 
-func Skill(game *dungeonsandtrolls.Game, skillUse *api.SkillUse, token string) error {
-	p, err := game.GetCurrentPlayer(token)
+func Skill(ctx context.Context, game *dungeonsandtrolls.Game, skillUse *api.SkillUse, token string) (*api.CommandResult, error) {
+	p, err := commandIssuer(game, token)
 	if err != nil {
-		return err
-	}
-	if p.IsAdmin {
-		return fmt.Errorf("admin players are are not allowed to call non-monster commands")
+		return nil, err
 	}
 
-	err = validateSkill(game, skillUse, p)
+	err = traced(ctx, "validateSkill", func() error { return validateSkill(game, skillUse, p) })
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pc := game.GetCommands(p.Character.Id)
 	pc.Skill = skillUse
+	commandsAccepted(ctx, game, p.Character.Id)
 
-	return nil
+	result := newCommandResult(game)
+	result.Skill = validationStatus(nil)
+	return result, nil
 }
@@ -1,42 +1,40 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
-	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
 )
 
-func validateYell(game *dungeonsandtrolls.Game, message *api.Message, p *gameobject.Player) error {
-	limit := 80
-	if p.IsAdmin {
-		limit = 200
-	}
+func validateYell(message *api.Message, limit int) error {
 	if len(message.Text) > limit {
-		return fmt.Errorf("message is too long >%d (we are not Twitter)", limit)
+		return apierror.New(api.ErrorCode_INVALID_ARGUMENT, "message is too long >%d (we are not Twitter)", limit).
+			With("length", len(message.Text)).
+			With("limit", limit)
 	}
 	return nil
 }
 
-func Yell(game *dungeonsandtrolls.Game, message *api.Message, token string) error {
-	p, err := game.GetCurrentPlayer(token)
+func Yell(ctx context.Context, game *dungeonsandtrolls.Game, message *api.Message, token string) (*api.CommandResult, error) {
+	p, err := commandIssuer(game, token)
 	if err != nil {
-		return err
-	}
-	if p.IsAdmin {
-		return fmt.Errorf("admin players are are not allowed to call non-monster commands")
+		return nil, err
 	}
 
 	// TODO translate IDs to names
 	// - consider IDs as one char?
 
-	err = validateYell(game, message, p)
+	err = traced(ctx, "validateYell", func() error { return validateYell(message, game.Config.Game.PlayerYellLimit) })
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pc := game.GetCommands(p.Character.Id)
 	pc.Yell = message
+	commandsAccepted(ctx, game, p.Character.Id)
 
-	return nil
+	result := newCommandResult(game)
+	result.Yell = validationStatus(nil)
+	return result, nil
 }
@@ -0,0 +1,149 @@
+// Delivery of the API keys to the newly registered users.
+
+package keydelivery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/discord"
+)
+
+// KeyDelivery sends the API key to the user identified by the handle (as sent in the registration).
+// The returned registration is sent back in the response.
+type KeyDelivery interface {
+	Deliver(apiKey string, handle string) (*api.Registration, error)
+}
+
+// Discord sends the key in a direct message (using DISCORD_TOKEN and GARAGE_GUILD_ID).
+type Discord struct{}
+
+func (Discord) Deliver(apiKey string, handle string) (*api.Registration, error) {
+	err := discord.SendAPIKeyToUser(apiKey, handle)
+	if err != nil {
+		return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "failed to send api key to %s, Discord user probably does not exist", handle)
+	}
+	return &api.Registration{}, nil
+}
+
+// Direct returns the key in the response (meant for local and dev servers).
+type Direct struct{}
+
+func (Direct) Deliver(apiKey string, handle string) (*api.Registration, error) {
+	return &api.Registration{ApiKey: &apiKey}, nil
+}
+
+// Writer writes the key as a line to the writer (e.g. os.Stdout).
+type Writer struct {
+	W    io.Writer
+	lock sync.Mutex
+}
+
+func (w *Writer) Deliver(apiKey string, handle string) (*api.Registration, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err := fmt.Fprintf(w.W, "%s %s\n", handle, apiKey)
+	return &api.Registration{}, err
+}
+
+// File appends the key as a line to the file.
+type File struct {
+	Path string
+	lock sync.Mutex
+}
+
+func (f *File) Deliver(apiKey string, handle string) (*api.Registration, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	file, err := os.OpenFile(f.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	_, err = fmt.Fprintf(file, "%s %s\n", handle, apiKey)
+	return &api.Registration{}, err
+}
+
+type webhookPayload struct {
+	Username string `json:"username"`
+	ApiKey   string `json:"api_key"`
+}
+
+// Webhook POSTs the key as JSON ({"username": ..., "api_key": ...}) to the URL.
+type Webhook struct {
+	URL    string
+	Client *http.Client
+}
+
+func (w *Webhook) Deliver(apiKey string, handle string) (*api.Registration, error) {
+	j, err := json.Marshal(webhookPayload{Username: handle, ApiKey: apiKey})
+	if err != nil {
+		return nil, err
+	}
+	client := w.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Post(w.URL, "application/json", bytes.NewReader(j))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("webhook responded with status %s", resp.Status)
+	}
+	return &api.Registration{}, nil
+}
+
+// Fake remembers the delivered keys (used in tests).
+type Fake struct {
+	Keys map[string]string
+	Err  error
+	lock sync.Mutex
+}
+
+func (f *Fake) Deliver(apiKey string, handle string) (*api.Registration, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.Keys == nil {
+		f.Keys = map[string]string{}
+	}
+	f.Keys[handle] = apiKey
+	return &api.Registration{}, nil
+}
+
+// FromConfig creates the configured delivery (discord, direct, stdout, file or webhook). Discord is used by default.
+func FromConfig(c config.KeyDelivery) (KeyDelivery, error) {
+	switch d := c.Method; d {
+	case "", "discord":
+		return Discord{}, nil
+	case "direct":
+		return Direct{}, nil
+	case "stdout":
+		return &Writer{W: os.Stdout}, nil
+	case "file":
+		if c.File == "" {
+			return nil, fmt.Errorf("key_delivery.file has to be set for the file key delivery")
+		}
+		return &File{Path: c.File}, nil
+	case "webhook":
+		if c.WebhookURL == "" {
+			return nil, fmt.Errorf("key_delivery.webhook_url has to be set for the webhook key delivery")
+		}
+		return &Webhook{URL: c.WebhookURL}, nil
+	default:
+		return nil, fmt.Errorf("unknown key delivery %s", d)
+	}
+}
@@ -0,0 +1,46 @@
+package keydelivery
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhook(t *testing.T) {
+	var received webhookPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	r, err := (&Webhook{URL: ts.URL}).Deliver("key", "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.ApiKey != nil {
+		t.Fatal("key should not be returned in the response")
+	}
+	if received.Username != "player" || received.ApiKey != "key" {
+		t.Fatalf("unexpected payload %v", received)
+	}
+
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	if _, err := (&Webhook{URL: ts.URL}).Deliver("key", "player"); err == nil {
+		t.Fatal("failed webhook should return an error")
+	}
+}
+
+func TestDirect(t *testing.T) {
+	r, err := Direct{}.Deliver("key", "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetApiKey() != "key" {
+		t.Fatal("key should be returned in the response")
+	}
+}
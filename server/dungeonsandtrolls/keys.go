@@ -0,0 +1,293 @@
+package dungeonsandtrolls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/rs/zerolog/log"
+	"sort"
+	"strings"
+	"time"
+)
+
+const apiKeysStorageKey = "api_keys"
+
+// Plaintext API keys with their roles (replaced by apiKeysStorageKey).
+const legacyRolesStorageKey = "roles"
+
+// Players by their plaintext API keys (replaced by apiKeysStorageKey).
+const legacyPlayersStorageKey = "player_api_keys"
+
+// ApiKey is stored in the keys bucket under the hash of the key (the key itself is not stored). The IDs are random
+// even in the deterministic games, the keys are not part of the replayed simulation.
+type ApiKey struct {
+	Id string `json:"id"`
+	// Name of the player the key belongs to (empty for keys without a Character, e.g. monster puppeteers).
+	Owner   string      `json:"owner,omitempty"`
+	Roles   []auth.Role `json:"roles"`
+	Label   string      `json:"label,omitempty"`
+	Created time.Time   `json:"created"`
+}
+
+func hashApiKey(apiKey string) string {
+	h := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(h[:])
+}
+
+// GenerateApiKey returns a new random API key.
+func GenerateApiKey() string {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		log.Fatal().Err(err).Msg("API key generation failed")
+	}
+	return hex.EncodeToString(b)
+}
+
+// loadApiKeys reads the keys from the storage. Players stored with plaintext keys (with the legacy roles or
+// without roles at all) are migrated to the hashed keys.
+func (g *Game) loadApiKeys() {
+	g.keysLock.Lock()
+	defer g.keysLock.Unlock()
+	err := g.stores.keys.ReadTo(apiKeysStorageKey, &g.apiKeys)
+	if err != nil {
+		log.Warn().Msgf("API keys were not loaded from the storage %v", err)
+	}
+	// missing since the keys were migrated
+	g.stores.keys.ReadTo(legacyPlayersStorageKey, &g.ApiKeyToPlayer)
+	if len(g.ApiKeyToPlayer) == 0 {
+		return
+	}
+
+	legacyRoles := map[string][]auth.Role{}
+	err = g.stores.keys.ReadTo(legacyRolesStorageKey, &legacyRoles)
+	if err != nil {
+		log.Warn().Msgf("Legacy roles were not loaded from the storage %v", err)
+	}
+	for key, p := range g.ApiKeyToPlayer {
+		k := &ApiKey{
+			Id:      gameobject.GetNewId(),
+			Owner:   p.GetName(),
+			Roles:   legacyRoles[key],
+			Created: time.Now(),
+		}
+		// The legacy admin flag (and the "leonidas" name prefix which used to mean a player who sees all the levels).
+		switch {
+		case p.IsAdmin:
+			k.Roles = []auth.Role{auth.RoleMonsterPuppeteer, auth.RoleOperator}
+		case len(k.Roles) > 0:
+		case strings.HasPrefix(p.GetName(), "leonidas"):
+			k.Roles = []auth.Role{auth.RolePlayer, auth.RoleSpectator}
+		default:
+			k.Roles = []auth.Role{auth.RolePlayer}
+		}
+		if p.IsAdmin {
+			// Admins do not have Characters in the game.
+			k.Owner = ""
+		} else {
+			g.Players[p.GetName()] = p
+		}
+		g.apiKeys[hashApiKey(key)] = k
+	}
+	log.Info().Msgf("migrated %d plaintext API keys", len(g.ApiKeyToPlayer))
+	g.ApiKeyToPlayer = nil
+	g.storeApiKeys()
+	for _, key := range []string{legacyRolesStorageKey, legacyPlayersStorageKey} {
+		if err := g.stores.keys.Delete(key); err != nil {
+			log.Warn().Err(err).Msgf("Legacy %s were not deleted", key)
+		}
+	}
+}
+
+// bootstrapOperator adds the configured operator key (the operator role cannot be issued by the players).
+func (g *Game) bootstrapOperator() {
+	apiKey := g.Config.Server.OperatorKey
+	if apiKey == "" {
+		return
+	}
+	g.keysLock.RLock()
+	_, ok := g.apiKeys[hashApiKey(apiKey)]
+	g.keysLock.RUnlock()
+	if ok {
+		return
+	}
+	g.AddApiKey(apiKey, "", []auth.Role{auth.RoleOperator}, "operator")
+	log.Info().Msg("operator API key was added")
+}
+
+func (g *Game) storeApiKeys() {
+	err := g.stores.keys.Write(apiKeysStorageKey, g.apiKeys)
+	if err != nil {
+		log.Warn().Err(err).Msg("API keys were not stored")
+	}
+}
+
+func (g *Game) apiKey(apiKey string) (*ApiKey, error) {
+	k, ok := g.apiKeys[hashApiKey(apiKey)]
+	if !ok {
+		return nil, apierror.New(api.ErrorCode_INVALID_API_KEY, "API key is not valid")
+	}
+	return k, nil
+}
+
+// Roles returns the roles bound to the API key.
+func (g *Game) Roles(apiKey string) ([]auth.Role, error) {
+	g.keysLock.RLock()
+	defer g.keysLock.RUnlock()
+	k, err := g.apiKey(apiKey)
+	if err != nil {
+		return nil, err
+	}
+	return k.Roles, nil
+}
+
+// ApiKeyId returns the public ID of the API key.
+func (g *Game) ApiKeyId(apiKey string) (string, error) {
+	g.keysLock.RLock()
+	defer g.keysLock.RUnlock()
+	k, err := g.apiKey(apiKey)
+	if err != nil {
+		return "", err
+	}
+	return k.Id, nil
+}
+
+// ApiKeyOwner returns the owner of the key identified by the key ID (empty if there is none).
+func (g *Game) ApiKeyOwner(id string) string {
+	g.keysLock.RLock()
+	defer g.keysLock.RUnlock()
+	for _, k := range g.apiKeys {
+		if k.Id == id {
+			return k.Owner
+		}
+	}
+	return ""
+}
+
+// AddApiKey binds the API key with the roles to the player (owner may be empty).
+func (g *Game) AddApiKey(apiKey string, owner string, roles []auth.Role, label string) *ApiKey {
+	g.keysLock.Lock()
+	defer g.keysLock.Unlock()
+	k := &ApiKey{
+		Id:      gameobject.GetNewId(),
+		Owner:   owner,
+		Roles:   roles,
+		Label:   label,
+		Created: time.Now(),
+	}
+	g.apiKeys[hashApiKey(apiKey)] = k
+	g.storeApiKeys()
+	return k
+}
+
+// ReplaceApiKey binds everything bound to the API key to the new key instead.
+func (g *Game) ReplaceApiKey(apiKey string, newKey string) error {
+	g.keysLock.Lock()
+	defer g.keysLock.Unlock()
+	k, err := g.apiKey(apiKey)
+	if err != nil {
+		return err
+	}
+	delete(g.apiKeys, hashApiKey(apiKey))
+	g.apiKeys[hashApiKey(newKey)] = k
+	g.storeApiKeys()
+	return nil
+}
+
+// IssueApiKey creates an additional key for the owner of the API key. The roles have to be a subset of the roles of
+// the API key (the viewer role is always allowed for player keys).
+func (g *Game) IssueApiKey(apiKey string, roles []auth.Role, label string) (string, error) {
+	g.keysLock.RLock()
+	k, err := g.apiKey(apiKey)
+	g.keysLock.RUnlock()
+	if err != nil {
+		return "", err
+	}
+	if len(roles) == 0 {
+		roles = []auth.Role{auth.RoleViewer}
+	}
+	for _, r := range roles {
+		if !auth.HasRole(k.Roles, r) && !(r == auth.RoleViewer && auth.HasRole(k.Roles, auth.RolePlayer)) {
+			return "", apierror.New(api.ErrorCode_PERMISSION_DENIED, "role %s cannot be issued", r)
+		}
+	}
+	newKey := GenerateApiKey()
+	g.AddApiKey(newKey, k.Owner, roles, label)
+	return newKey, nil
+}
+
+// ApiKeys lists the keys of the owner of the API key, or of the given owner (operators only).
+func (g *Game) ApiKeys(apiKey string, owner string) (*api.ApiKeys, error) {
+	g.keysLock.RLock()
+	defer g.keysLock.RUnlock()
+	current, err := g.apiKey(apiKey)
+	if err != nil {
+		return nil, err
+	}
+	if owner != "" && owner != current.Owner && !auth.HasRole(current.Roles, auth.RoleOperator) {
+		return nil, apierror.New(api.ErrorCode_PERMISSION_DENIED, "only operators can list the keys of other players")
+	}
+	if owner == "" {
+		owner = current.Owner
+	}
+	keys := &api.ApiKeys{}
+	for _, k := range g.apiKeys {
+		if k != current && (k.Owner != owner || k.Owner == "") {
+			continue
+		}
+		info := &api.ApiKeyInfo{
+			Id:      k.Id,
+			Created: k.Created.Unix(),
+			Current: k == current,
+		}
+		if k.Owner != "" {
+			info.Owner = &k.Owner
+		}
+		for _, r := range k.Roles {
+			info.Roles = append(info.Roles, string(r))
+		}
+		if k.Label != "" {
+			info.Label = &k.Label
+		}
+		keys.Keys = append(keys.Keys, info)
+	}
+	sort.Slice(keys.Keys, func(i, j int) bool {
+		return keys.Keys[i].Created < keys.Keys[j].Created ||
+			keys.Keys[i].Created == keys.Keys[j].Created && keys.Keys[i].Id < keys.Keys[j].Id
+	})
+	return keys, nil
+}
+
+// RevokeApiKey removes the key (identified by the key ID) of the owner of the API key (operators can remove any key).
+func (g *Game) RevokeApiKey(apiKey string, id string) error {
+	g.keysLock.Lock()
+	defer g.keysLock.Unlock()
+	current, err := g.apiKey(apiKey)
+	if err != nil {
+		return err
+	}
+	for h, k := range g.apiKeys {
+		if k.Id == id && (k == current || k.Owner != "" && k.Owner == current.Owner || auth.HasRole(current.Roles, auth.RoleOperator)) {
+			delete(g.apiKeys, h)
+			g.storeApiKeys()
+			return nil
+		}
+	}
+	return apierror.New(api.ErrorCode_NOT_FOUND, "API key %s not found", id).With("id", id)
+}
+
+// revokePlayerKeys removes all the keys of the player.
+func (g *Game) revokePlayerKeys(owner string) {
+	g.keysLock.Lock()
+	defer g.keysLock.Unlock()
+	for h, k := range g.apiKeys {
+		if k.Owner == owner {
+			delete(g.apiKeys, h)
+		}
+	}
+	g.storeApiKeys()
+}
@@ -0,0 +1,139 @@
+package dungeonsandtrolls
+
+import (
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+)
+
+func TestApiKeys(t *testing.T) {
+	g := NewGame(config.Default())
+	g.AddApiKey("key", "player", []auth.Role{auth.RolePlayer}, "")
+	g.AddApiKey("other", "other player", []auth.Role{auth.RolePlayer}, "")
+	if _, ok := g.apiKeys["key"]; ok {
+		t.Fatal("key should be stored hashed")
+	}
+
+	viewerKey, err := g.IssueApiKey("key", nil, "dashboard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	roles, err := g.Roles(viewerKey)
+	if err != nil || len(roles) != 1 || roles[0] != auth.RoleViewer {
+		t.Fatalf("read-only key expected, got %v", roles)
+	}
+	if _, err := g.IssueApiKey("key", []auth.Role{auth.RoleSpectator}, ""); apierror.Code(err) != api.ErrorCode_PERMISSION_DENIED {
+		t.Fatal("key with more roles than the caller issued")
+	}
+
+	keys, err := g.ApiKeys("key", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys.Keys) != 2 {
+		t.Fatalf("expected 2 keys of the player, got %d", len(keys.Keys))
+	}
+	var viewerId string
+	for _, k := range keys.Keys {
+		if !k.Current {
+			viewerId = k.Id
+		}
+	}
+	if err := g.RevokeApiKey("other", viewerId); apierror.Code(err) != api.ErrorCode_NOT_FOUND {
+		t.Fatal("key of another player revoked")
+	}
+	if err := g.RevokeApiKey("key", viewerId); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Roles(viewerKey); apierror.Code(err) != api.ErrorCode_INVALID_API_KEY {
+		t.Fatal("revoked key still works")
+	}
+	if _, err := g.ApiKeys("other", "player"); apierror.Code(err) != api.ErrorCode_PERMISSION_DENIED {
+		t.Fatal("keys of another player listed")
+	}
+
+	if err := g.ReplaceApiKey("key", "new key"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Roles("key"); err == nil {
+		t.Fatal("rotated key still works")
+	}
+	if _, err := g.Roles("new key"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLegacyApiKeysMigration(t *testing.T) {
+	g := NewGame(config.Default())
+	admin := gameobject.CreatePlayer(gameobject.GetNewId(), "admin", config.Default().Balance)
+	admin.IsAdmin = true
+	g.ApiKeyToPlayer = map[string]*gameobject.Player{
+		"player key": gameobject.CreatePlayer(gameobject.GetNewId(), "player", config.Default().Balance),
+		"admin key":  admin,
+	}
+	g.loadApiKeys()
+
+	if g.ApiKeyToPlayer != nil {
+		t.Fatal("plaintext keys should be dropped")
+	}
+	p, err := g.GetPlayerByKey("player key")
+	if err != nil || p.GetName() != "player" {
+		t.Fatal("player was not migrated")
+	}
+	roles, err := g.Roles("admin key")
+	if err != nil || !auth.HasRole(roles, auth.RoleMonsterPuppeteer) || !auth.HasRole(roles, auth.RoleOperator) {
+		t.Fatal("admin was not migrated to a monster puppeteer and an operator")
+	}
+	if _, ok := g.Players["admin"]; ok {
+		t.Fatal("admin should not have a Character")
+	}
+}
+
+func TestOperatorBootstrap(t *testing.T) {
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	c.Server.OperatorKey = "operator key"
+	g := NewGame(c)
+	g.bootstrapOperator()
+	g.bootstrapOperator()
+	if len(g.apiKeys) != 1 {
+		t.Fatalf("operator key should be added once, got %d keys", len(g.apiKeys))
+	}
+
+	operatorKey, err := g.IssueApiKey("operator key", []auth.Role{auth.RoleOperator}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	roles, err := g.Roles(operatorKey)
+	if err != nil || !auth.HasRole(roles, auth.RoleOperator) {
+		t.Fatalf("operator key expected, got %v", roles)
+	}
+	g.AddApiKey("key", "player", []auth.Role{auth.RolePlayer}, "")
+	if _, err := g.IssueApiKey("key", []auth.Role{auth.RoleOperator}, ""); apierror.Code(err) != api.ErrorCode_PERMISSION_DENIED {
+		t.Fatal("player issued an operator key")
+	}
+
+	keys, err := g.ApiKeys(operatorKey, "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var playerKeyId string
+	for _, k := range keys.Keys {
+		if !k.Current && k.GetOwner() == "player" {
+			playerKeyId = k.Id
+		}
+	}
+	if len(keys.Keys) != 2 || playerKeyId == "" {
+		t.Fatalf("expected the operator key and the key of the player, got %v", keys.Keys)
+	}
+	if err := g.RevokeApiKey(operatorKey, playerKeyId); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Roles("key"); apierror.Code(err) != api.ErrorCode_INVALID_API_KEY {
+		t.Fatal("key revoked by the operator still works")
+	}
+}
@@ -0,0 +1,102 @@
+package dungeonsandtrolls
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/metrics"
+)
+
+// lockstep tracks the players who have not submitted their commands for the next tick yet.
+type lockstep struct {
+	lock sync.Mutex
+	// names of the waited players by their IDs
+	waiting map[string]string
+	// ticks missed in a row by the player IDs (the players who submitted in the last tick are not present)
+	missed map[string]int32
+	// signalled once the last waited player submits
+	submitted chan struct{}
+}
+
+func newLockstep() *lockstep {
+	return &lockstep{submitted: make(chan struct{}, 1), missed: map[string]int32{}}
+}
+
+// expectSubmissions starts waiting for the commands of the active players (not stunned and not idle, see
+// LockstepIdleTicks). It is called at the end of the tick with the GameLock held.
+func (g *Game) expectSubmissions() {
+	g.lockstep.lock.Lock()
+	defer g.lockstep.lock.Unlock()
+	waiting := map[string]string{}
+	for _, p := range g.Players {
+		if !p.Stun().GetIsStunned() && g.lockstep.missed[p.GetId()] < g.Config.Game.LockstepIdleTicks {
+			waiting[p.GetId()] = p.GetName()
+		}
+	}
+	g.lockstep.waiting = waiting
+	// drop the signal of the previous tick
+	select {
+	case <-g.lockstep.submitted:
+	default:
+	}
+}
+
+// CommandsSubmitted marks the commands of the player as submitted for the next tick.
+func (g *Game) CommandsSubmitted(pId string) {
+	if !g.Config.Game.Lockstep {
+		return
+	}
+	g.lockstep.lock.Lock()
+	defer g.lockstep.lock.Unlock()
+	// idle players are waited for again from the next tick
+	delete(g.lockstep.missed, pId)
+	if _, ok := g.lockstep.waiting[pId]; !ok {
+		return
+	}
+	delete(g.lockstep.waiting, pId)
+	if len(g.lockstep.waiting) == 0 {
+		select {
+		case g.lockstep.submitted <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// waitForSubmissions waits until all the active players submit their commands or the timeout passes. Without active
+// players the whole timeout is waited.
+func (g *Game) waitForSubmissions(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-g.lockstep.submitted:
+	case <-time.After(g.Config.Game.LockstepTimeout):
+	}
+}
+
+// reportMissedSubmissions logs the event with the players who did not submit their commands in time. It is called at
+// the start of the tick with the GameLock held.
+func (g *Game) reportMissedSubmissions() {
+	g.lockstep.lock.Lock()
+	var missed []string
+	for id, name := range g.lockstep.waiting {
+		missed = append(missed, fmt.Sprintf("%s (%s)", name, id))
+		g.lockstep.missed[id]++
+	}
+	g.lockstep.waiting = nil
+	g.lockstep.lock.Unlock()
+
+	if len(missed) == 0 {
+		return
+	}
+	sort.Strings(missed)
+	metrics.LockstepMissedSubmissions.Add(float64(len(missed)))
+	missedEvent := api.Event_MISSED_TICK
+	g.LogEvent(&api.Event{
+		Type:    &missedEvent,
+		Message: fmt.Sprintf("players missed the tick %d: %s", g.Game.Tick, strings.Join(missed, ", ")),
+	})
+}
@@ -0,0 +1,71 @@
+package dungeonsandtrolls
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+)
+
+func TestLockstep(t *testing.T) {
+	c := config.Default()
+	c.Game.Lockstep = true
+	c.Game.LockstepTimeout = 50 * time.Millisecond
+	g := NewGame(c)
+	fast := gameobject.CreatePlayer("fast id", "fast", c.Balance)
+	slow := gameobject.CreatePlayer("slow id", "slow", c.Balance)
+	g.Players[fast.GetName()] = fast
+	g.Players[slow.GetName()] = slow
+
+	g.expectSubmissions()
+	g.CommandsSubmitted(fast.GetId())
+	start := time.Now()
+	g.waitForSubmissions(context.Background())
+	if time.Since(start) < c.Game.LockstepTimeout {
+		t.Fatal("tick should wait for the slow player")
+	}
+	g.reportMissedSubmissions()
+	if len(g.Game.Events) != 1 || g.Game.Events[0].GetType() != api.Event_MISSED_TICK ||
+		!strings.Contains(g.Game.Events[0].Message, "slow") || strings.Contains(g.Game.Events[0].Message, "fast") {
+		t.Fatalf("only the slow player should miss the tick: %v", g.Game.Events)
+	}
+
+	g.Game.Events = nil
+	c.Game.LockstepTimeout = time.Minute
+	g.expectSubmissions()
+	g.CommandsSubmitted(slow.GetId())
+	g.CommandsSubmitted(fast.GetId())
+	g.waitForSubmissions(context.Background())
+	g.reportMissedSubmissions()
+	if len(g.Game.Events) != 0 {
+		t.Fatalf("nobody should miss the tick: %v", g.Game.Events)
+	}
+
+	// the slow player goes offline
+	c.Game.LockstepTimeout = 10 * time.Millisecond
+	for i := int32(0); i < c.Game.LockstepIdleTicks; i++ {
+		g.expectSubmissions()
+		g.CommandsSubmitted(fast.GetId())
+		g.waitForSubmissions(context.Background())
+		g.reportMissedSubmissions()
+	}
+	c.Game.LockstepTimeout = time.Minute
+	g.expectSubmissions()
+	if _, ok := g.lockstep.waiting[slow.GetId()]; ok {
+		t.Fatal("idle player should not be waited for")
+	}
+	g.CommandsSubmitted(fast.GetId())
+	g.waitForSubmissions(context.Background())
+	g.reportMissedSubmissions()
+
+	// and comes back
+	g.CommandsSubmitted(slow.GetId())
+	g.expectSubmissions()
+	if _, ok := g.lockstep.waiting[slow.GetId()]; !ok {
+		t.Fatal("player who submitted again should be waited for")
+	}
+}
@@ -49,8 +49,6 @@ func ParseMap(mapJson string) (*api.Map, error) {
 		m.Levels = append(m.Levels, l)
 	}
 
-	addIds(m)
-
 	return m, nil
 }
 
@@ -265,28 +263,6 @@ func parseMapObjects(tile map[string]interface{}, o *api.MapObjects) error {
 	return nil
 }
 
-func addIds(mp *api.Map) {
-	for _, l := range mp.Levels {
-		for _, o := range l.Objects {
-			for _, m := range o.Monsters {
-				// Items are not needed
-				m.Id = gameobject.GetNewId()
-				for _, i := range m.EquippedItems {
-					for _, s := range i.Skills {
-						s.Id = gameobject.GetNewId()
-					}
-				}
-			}
-			for _, i := range o.Items {
-				i.Id = gameobject.GetNewId()
-				for _, s := range i.Skills {
-					s.Id = gameobject.GetNewId()
-				}
-			}
-		}
-	}
-}
-
 func findLevelSpawnPoint(l *api.Level) (*api.Coordinates, error) {
 	for _, o := range l.Objects {
 		if o.IsSpawn != nil && *o.IsSpawn {
@@ -375,31 +351,39 @@ func LevelsPostProcessing(g *Game, m *api.Map, mapCache *MapCache) error {
 			}
 		}
 
-		lc := mapCache.createLevelCache(l.Level)
-		lc.Width = l.Width
-		lc.Height = l.Height
-		lc.GeneratedTick = g.Game.Tick
-		lc.LastInteractedTick = g.Game.Tick
-		lc.Grid = paths.NewGrid(int(l.Width), int(l.Height), 1, 1)
-
-		var x, y int32
-		for x = 0; x < l.Width; x++ {
-			lc.Fow[x] = map[int32]bool{}
-			for y = 0; y < l.Height; y++ {
-				// TODO enable FOW here + special handling for 0 level
-				lc.Fow[x][y] = false
-			}
-		}
-
-		spawn, err := findLevelSpawnPoint(l)
-		if err != nil {
+		if _, err := mapCache.cacheLevel(l, g.Game.Tick); err != nil {
 			return err
 		}
-		lc.SpawnPoint = spawn
+	}
+	return nil
+}
 
-		for _, o := range l.Objects {
-			lc.CacheObjectsOnPosition(gameobject.PositionToCoordinates(o.Position, l.Level), o)
+// cacheLevel creates the cache of the level objects and its path finding grid.
+func (m *MapCache) cacheLevel(l *api.Level, tick int32) (*LevelCache, error) {
+	lc := m.createLevelCache(l.Level)
+	lc.Width = l.Width
+	lc.Height = l.Height
+	lc.GeneratedTick = tick
+	lc.LastInteractedTick = tick
+	lc.Grid = paths.NewGrid(int(l.Width), int(l.Height), 1, 1)
+
+	var x, y int32
+	for x = 0; x < l.Width; x++ {
+		lc.Fow[x] = map[int32]bool{}
+		for y = 0; y < l.Height; y++ {
+			// TODO enable FOW here + special handling for 0 level
+			lc.Fow[x][y] = false
 		}
 	}
-	return nil
+
+	spawn, err := findLevelSpawnPoint(l)
+	if err != nil {
+		return nil, err
+	}
+	lc.SpawnPoint = spawn
+
+	for _, o := range l.Objects {
+		lc.CacheObjectsOnPosition(gameobject.PositionToCoordinates(o.Position, l.Level), o)
+	}
+	return lc, nil
 }
@@ -0,0 +1,121 @@
+// Prometheus metrics of the game loop and of the API.
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "dungeonsandtrolls"
+
+var (
+	TickDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "tick_duration_seconds",
+		Help:      "Time spent processing a tick (including waiting for the game lock and storing the state).",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, .75, 1, 1.5, 2, 5},
+	})
+	TickUtilization = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "tick_utilization_ratio",
+		Help:      "Part of the loop time used by the tick processing (above 1 means the tick overran).",
+		Buckets:   []float64{.01, .05, .1, .25, .5, .75, .9, 1, 1.5, 2},
+	})
+	TickOverruns = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tick_overruns_total",
+		Help:      "Ticks which took longer than the loop time.",
+	})
+	LockstepMissedSubmissions = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "lockstep_missed_submissions_total",
+		Help:      "Players who did not submit their commands before the lockstep timeout.",
+	})
+	GeneratorDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "generator_duration_seconds",
+		Help:      "Time spent generating levels (the count is the number of generator runs).",
+		Buckets:   []float64{.1, .25, .5, 1, 2, 5, 10, 20, 30, 60},
+	})
+	Players = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "players",
+		Help:      "Players per level.",
+	}, []string{"level"})
+	Monsters = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "monsters",
+		Help:      "Monsters per level.",
+	}, []string{"level"})
+	Objects = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "objects",
+		Help:      "Registered objects per level (objects without a position are on the level \"none\").",
+	}, []string{"level"})
+	Events = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_total",
+		Help:      "Emitted game events per type.",
+	}, []string{"type"})
+	RpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "Latency of the RPCs (of the whole stream for the streaming RPCs).",
+		Buckets:   prometheus.ExponentialBuckets(.001, 2.5, 12),
+	}, []string{"method", "code"})
+	GameLockWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "game_lock_wait_seconds",
+		Help:      "Time spent waiting for the game lock.",
+		Buckets:   prometheus.ExponentialBuckets(.0001, 3, 12),
+	}, []string{"mode"})
+)
+
+// Handler serves the metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RWMutex measures how long it takes to acquire the lock.
+type RWMutex struct {
+	sync.RWMutex
+}
+
+func (m *RWMutex) Lock() {
+	start := time.Now()
+	m.RWMutex.Lock()
+	GameLockWait.WithLabelValues("write").Observe(time.Since(start).Seconds())
+}
+
+func (m *RWMutex) RLock() {
+	start := time.Now()
+	m.RWMutex.RLock()
+	GameLockWait.WithLabelValues("read").Observe(time.Since(start).Seconds())
+}
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		RpcDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		RpcDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
@@ -0,0 +1,135 @@
+package dungeonsandtrolls
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// Files and keys of the storage used before the buckets.
+const (
+	legacyUserStorageFile  = "users.json"
+	legacyGameStorageFile  = "game.json"
+	legacyWorldStorageFile = "world.json"
+
+	legacyGameStorageKey     = "game"
+	legacyGameTickStorageKey = "game_tick"
+)
+
+// legacyGame is the whole game stored under the legacy game key.
+type legacyGame struct {
+	Score           float32                    `json:"score"`
+	Players         map[string]json.RawMessage `json:"players"`
+	ApiKeyToPlayer  map[string]json.RawMessage `json:"player_api_keys,omitempty"`
+	MaxLevelReached int32                      `json:"max_reached_level"`
+	BannedUsers     map[string]bool            `json:"banned_users"`
+}
+
+// migrateLegacyStorage migrates the legacy files found in the storage directory when the game starts. The migrated
+// files are renamed (so they are migrated once), the game does not start when they cannot be migrated.
+func migrateLegacyStorage(c config.Storage) error {
+	_, usersErr := os.Stat(filepath.Join(c.Path, legacyUserStorageFile))
+	_, gameErr := os.Stat(filepath.Join(c.Path, legacyGameStorageFile))
+	if usersErr != nil && gameErr != nil {
+		return nil
+	}
+	if err := MigrateStorage(c.Path, c); err != nil {
+		return fmt.Errorf("game stored in %s before the storage buckets was not migrated: %w", c.Path, err)
+	}
+	for _, f := range []string{legacyUserStorageFile, legacyGameStorageFile, legacyWorldStorageFile} {
+		path := filepath.Join(c.Path, f)
+		if err := os.Rename(path, path+".migrated"); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("migrated file was not renamed: %w", err)
+		}
+	}
+	return nil
+}
+
+// MigrateStorage copies the game stored in the legacy JSON files in the directory to the configured storage which
+// must not contain a game yet.
+func MigrateStorage(from string, c config.Storage) error {
+	users, err := storage.NewFileStore(filepath.Join(from, legacyUserStorageFile))
+	if err != nil {
+		return err
+	}
+	gameStore, err := storage.NewFileStore(filepath.Join(from, legacyGameStorageFile))
+	if err != nil {
+		return err
+	}
+	world, err := storage.NewFileStore(filepath.Join(from, legacyWorldStorageFile))
+	if err != nil {
+		return err
+	}
+	game := legacyGame{}
+	if err := gameStore.ReadTo(legacyGameStorageKey, &game); err != nil {
+		return err
+	}
+	var tick int32
+	if err := gameStore.ReadTo(legacyGameTickStorageKey, &tick); err != nil {
+		return err
+	}
+
+	backend, err := storage.Open(c.Backend, c.Path)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	buckets := map[string]storage.Store{}
+	for _, name := range []string{playersBucket, keysBucket, scoresBucket, snapshotsBucket} {
+		if buckets[name], err = backend.Bucket(name); err != nil {
+			return err
+		}
+	}
+	scores, players, keys, snapshots := buckets[scoresBucket], buckets[playersBucket], buckets[keysBucket], buckets[snapshotsBucket]
+	var storedTick int32
+	if err := scores.ReadTo(tickKey, &storedTick); err == nil {
+		return fmt.Errorf("%s storage in %s already contains a game", c.Backend, c.Path)
+	}
+
+	values := map[string]any{}
+	for name, p := range game.Players {
+		values[name] = p
+	}
+	if err := players.WriteAll(values); err != nil {
+		return fmt.Errorf("players: %w", err)
+	}
+
+	values = map[string]any{}
+	for _, key := range []string{apiKeysStorageKey, legacyRolesStorageKey} {
+		var v json.RawMessage
+		if err := users.ReadTo(key, &v); err == nil {
+			values[key] = v
+		}
+	}
+	if len(game.ApiKeyToPlayer) > 0 {
+		values[legacyPlayersStorageKey] = game.ApiKeyToPlayer
+	}
+	if err := keys.WriteAll(values); err != nil {
+		return fmt.Errorf("keys: %w", err)
+	}
+
+	var snapshot json.RawMessage
+	if err := world.ReadTo(worldStorageKey, &snapshot); err == nil {
+		if err := snapshots.Write(worldStorageKey, snapshot); err != nil {
+			return fmt.Errorf("world snapshot: %w", err)
+		}
+	}
+
+	// written last, the game is not loaded without the scores
+	err = scores.WriteAll(map[string]any{
+		tickKey:            tick,
+		scoreKey:           game.Score,
+		maxLevelReachedKey: game.MaxLevelReached,
+		bannedUsersKey:     game.BannedUsers,
+	})
+	if err != nil {
+		return fmt.Errorf("scores: %w", err)
+	}
+	log.Info().Msgf("Migrated %d players from %s to the %s storage in %s", len(game.Players), from, c.Backend, c.Path)
+	return nil
+}
@@ -0,0 +1,103 @@
+package dungeonsandtrolls
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/storage"
+	"golang.org/x/exp/slices"
+)
+
+// writeLegacyGame copies the game stored by the server before the storage buckets (a player and an admin bound to
+// the plaintext API keys in the game file, see testdata/legacy).
+func writeLegacyGame(t *testing.T, legacy string) {
+	j, err := os.ReadFile(filepath.Join("testdata", "legacy", legacyGameStorageFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(legacy, legacyGameStorageFile), j, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateStorage(t *testing.T) {
+	legacy := t.TempDir()
+	writeLegacyGame(t, legacy)
+
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	c.Storage.Backend = storage.BoltBackend
+	if err := MigrateStorage(legacy, c.Storage); err != nil {
+		t.Fatal(err)
+	}
+	if err := MigrateStorage(legacy, c.Storage); err == nil {
+		t.Fatal("storage with a game should not be overwritten")
+	}
+
+	g := NewGame(c)
+	defer g.stores.close()
+	if err := g.stores.scores.ReadTo(tickKey, &g.Game.Tick); err != nil || g.Game.Tick != 42 {
+		t.Fatalf("tick should be migrated, %d: %v", g.Game.Tick, err)
+	}
+	if err := g.loadGameState(); err != nil {
+		t.Fatal(err)
+	}
+	g.loadApiKeys()
+	if g.Score != 3 || g.MaxLevelReached != 7 {
+		t.Fatal("scores should be migrated")
+	}
+	if mp, ok := g.Players["player"]; !ok || mp.GetId() != "player-id" || mp.Character.Money != 120 {
+		t.Fatalf("player should be decoded from the legacy character: %v", mp)
+	}
+	if _, ok := g.Players["admin"]; ok {
+		t.Fatal("admin should not have a character")
+	}
+	if _, ok := g.apiKeys["player-secret"]; ok {
+		t.Fatal("plaintext API key should not be kept")
+	}
+	if k, err := g.apiKey("player-secret"); err != nil || k.Owner != "player" || !slices.Equal(k.Roles, []auth.Role{auth.RolePlayer}) {
+		t.Fatalf("player key should be hashed with the player role, %v: %v", k, err)
+	}
+	if k, err := g.apiKey("admin-secret"); err != nil || k.Owner != "" || !slices.Contains(k.Roles, auth.RoleOperator) {
+		t.Fatalf("admin flag should be mapped to the operator role, %v: %v", k, err)
+	}
+	var legacyKeys json.RawMessage
+	if err := g.stores.keys.ReadTo(legacyPlayersStorageKey, &legacyKeys); err == nil {
+		t.Fatal("plaintext API keys should be deleted once migrated")
+	}
+}
+
+func TestMigrateOnStartup(t *testing.T) {
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	writeLegacyGame(t, c.Storage.Path)
+	if err := migrateLegacyStorage(c.Storage); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(c.Storage.Path, legacyGameStorageFile+".migrated")); err != nil {
+		t.Fatalf("migrated file should be renamed: %v", err)
+	}
+	// migrated only once
+	if err := migrateLegacyStorage(c.Storage); err != nil {
+		t.Fatal(err)
+	}
+	g := NewGame(c)
+	defer g.stores.close()
+	if err := g.loadGameState(); err != nil {
+		t.Fatalf("game should be migrated: %v", err)
+	}
+	g.loadApiKeys()
+	if g.Players["player"] == nil {
+		t.Fatal("player should be migrated")
+	}
+
+	// legacy files next to a stored game are not ignored
+	writeLegacyGame(t, c.Storage.Path)
+	if err := migrateLegacyStorage(c.Storage); err == nil {
+		t.Fatal("startup should fail while the legacy files cannot be migrated")
+	}
+}
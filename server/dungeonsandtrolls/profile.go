@@ -0,0 +1,82 @@
+package dungeonsandtrolls
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// profile returns the lifetime stats of the player (created on the first use). The caller has to hold the GameLock.
+func (g *Game) profile(name string) *api.PlayerProfile {
+	pr, ok := g.profiles[name]
+	if !ok {
+		pr = &api.PlayerProfile{Name: name}
+		g.profiles[name] = pr
+	}
+	return pr
+}
+
+// Profile returns the lifetime stats of the player.
+func (g *Game) Profile(name string) (*api.PlayerProfile, error) {
+	g.GameLock.RLock()
+	defer g.GameLock.RUnlock()
+	pr, ok := g.profiles[name]
+	if !ok {
+		return nil, apierror.New(api.ErrorCode_NOT_FOUND, "profile of %s not found", name)
+	}
+	return proto.Clone(pr).(*api.PlayerProfile), nil
+}
+
+// recordDamage adds the damage taken to the profile of the receiver and remembers who finished it (the kills are
+// credited at the death, see creditDeath). The measures shared with the leaderboard are counted from the events, see
+// recordStats.
+func (g *Game) recordDamage(attacker gameobject.Ider, receiver gameobject.Alive, damage float32, killed bool) {
+	if killed {
+		g.killers[receiver.GetId()] = g.creditedId(attacker.GetId())
+	}
+	if p, ok := receiver.(*gameobject.Player); ok {
+		g.profile(p.GetName()).DamageTaken += damage
+	}
+}
+
+func (g *Game) loadProfiles() error {
+	names, err := g.stores.profiles.Keys()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		var j json.RawMessage
+		if err := g.stores.profiles.ReadTo(name, &j); err != nil {
+			return fmt.Errorf("profile %s: %w", name, err)
+		}
+		pr := &api.PlayerProfile{}
+		if err := protojson.Unmarshal(j, pr); err != nil {
+			return fmt.Errorf("profile %s: %w", name, err)
+		}
+		g.profiles[name] = pr
+	}
+	return nil
+}
+
+// storeProfiles queues the profiles to the storage (only the changed ones are written). The caller has to hold the
+// GameLock.
+func (g *Game) storeProfiles() {
+	profiles := map[string]any{}
+	for name, pr := range g.profiles {
+		j, err := protojson.Marshal(pr)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Profile of %s was not stored", name)
+			continue
+		}
+		profiles[name] = json.RawMessage(j)
+	}
+	if err := g.stores.profiles.WriteAll(profiles); err != nil {
+		log.Warn().Err(err).Msg("Profiles were not stored")
+	}
+}
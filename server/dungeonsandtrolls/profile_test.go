@@ -0,0 +1,59 @@
+package dungeonsandtrolls
+
+import (
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProfile(t *testing.T) {
+	g := newTestGame(t)
+	attacker := gameobject.CreatePlayer(g.NewId(), "attacker", g.Balance())
+	g.AddPlayer(attacker)
+	victim := gameobject.CreatePlayer(g.NewId(), "victim", g.Balance())
+	g.AddPlayer(victim)
+
+	attackerId := attacker.GetId()
+	hit := func() {
+		e := &api.Effect{Effects: &api.Attributes{}, DamageAmount: 1000, DamageType: api.DamageType_slash, XCasterId: &attackerId, Duration: 1}
+		if _, err := EvaluateEffects(g, []*api.Effect{e}, victim.GetAttributes(), victim); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hit()
+	// the victim is already dead
+	hit()
+	g.Respawn(victim, true)
+	g.recordStats()
+
+	a, err := g.Profile("attacker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := g.Profile("victim")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Kills != 1 || a.DamageDealt <= 0 || a.DamageDealt != v.DamageTaken {
+		t.Fatalf("attacker should get the damage and a single kill: %v", a)
+	}
+	if v.Deaths != 1 || v.MoneyReceived != 2*g.GetMoney() || v.TicksAlive != 1 || a.Deaths != 0 {
+		t.Fatalf("victim should die and get the money twice: %v", v)
+	}
+	if _, err := g.Profile("nobody"); err == nil {
+		t.Fatal("unknown player should not have a profile")
+	}
+
+	g.storeGameState()
+	g.stores.close()
+	restored := NewGame(g.Config)
+	defer restored.stores.close()
+	if err := restored.loadGameState(); err != nil {
+		t.Fatal(err)
+	}
+	if ra, err := restored.Profile("attacker"); err != nil || !proto.Equal(ra, a) {
+		t.Fatalf("profile should be restored, %v: %v", ra, err)
+	}
+}
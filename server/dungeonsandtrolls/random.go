@@ -0,0 +1,125 @@
+package dungeonsandtrolls
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// lockedSource is a rand.Source which can be shared by the goroutines.
+type lockedSource struct {
+	lock sync.Mutex
+	src  rand.Source64
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.src.Uint64()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.src.Seed(seed)
+}
+
+func newRand(seed int64) *rand.Rand {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return rand.New(&lockedSource{src: rand.NewSource(seed).(rand.Source64)})
+}
+
+// Deterministic reports whether the game runs with a configured seed.
+func (g *Game) Deterministic() bool {
+	return g.Config.Game.Seed != 0
+}
+
+// Rand is the random generator of the game, it is seeded by the configured seed in the deterministic mode.
+func (g *Game) Rand() *rand.Rand {
+	return g.rand
+}
+
+// NewId returns a random ID, the IDs are derived from the seed in the deterministic mode.
+func (g *Game) NewId() string {
+	if !g.Deterministic() {
+		return gameobject.GetNewId()
+	}
+	// the bytes are taken from the locked source (rand.Rand.Read is not safe for concurrent use)
+	var id uuid.UUID
+	for i := 0; i < len(id); i += 8 {
+		v := g.rand.Uint64()
+		for j := 0; j < 8; j++ {
+			id[i+j] = byte(v >> (8 * j))
+		}
+	}
+	// version 4, variant RFC 4122
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return id.String()
+}
+
+// generatorSeed is the seed of the next generated map, zero (no seed) outside of the deterministic mode.
+func (g *Game) generatorSeed() int64 {
+	if !g.Deterministic() {
+		return 0
+	}
+	return g.generatorSeeds()
+}
+
+// sortedObjects returns the registered objects ordered by their IDs to process them in a stable order.
+func (g *Game) sortedObjects() []gameobject.Ider {
+	ids := maps.Keys(g.idToObject)
+	slices.Sort(ids)
+	objects := make([]gameobject.Ider, 0, len(ids))
+	for _, id := range ids {
+		objects = append(objects, g.idToObject[id])
+	}
+	return objects
+}
+
+// sortedPlayers returns the players ordered by their names.
+func (g *Game) sortedPlayers() []*gameobject.Player {
+	players := maps.Values(g.Players)
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].GetName() < players[j].GetName()
+	})
+	return players
+}
+
+// addIds assigns new IDs to the generated monsters, items and their skills.
+func (g *Game) addIds(mp *api.Map) {
+	for _, l := range mp.Levels {
+		for _, o := range l.Objects {
+			for _, m := range o.Monsters {
+				// Items are not needed
+				m.Id = g.NewId()
+				for _, i := range m.EquippedItems {
+					for _, s := range i.Skills {
+						s.Id = g.NewId()
+					}
+				}
+			}
+			for _, i := range o.Items {
+				i.Id = g.NewId()
+				for _, s := range i.Skills {
+					s.Id = g.NewId()
+				}
+			}
+		}
+	}
+}
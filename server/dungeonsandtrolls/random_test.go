@@ -0,0 +1,59 @@
+package dungeonsandtrolls
+
+import (
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/google/uuid"
+)
+
+func TestDeterministicGame(t *testing.T) {
+	run := func(seed int64) []interface{} {
+		c := config.Default()
+		c.Storage.Path = t.TempDir()
+		c.Game.Seed = seed
+		g := NewGame(c)
+		var out []interface{}
+		for i := 0; i < 3; i++ {
+			p := gameobject.CreatePlayer(g.NewId(), string(rune('c'-i)), g.Balance())
+			g.Players[p.GetName()] = p
+			g.Register(p)
+			out = append(out, p.GetId(), gameobject.RoundSkill(1.5, g.Rand()))
+		}
+		for _, o := range g.sortedObjects() {
+			out = append(out, o.GetId())
+		}
+		for _, p := range g.sortedPlayers() {
+			out = append(out, p.GetName())
+		}
+		out = append(out, g.generatorSeed())
+		return out
+	}
+
+	first, second := run(42), run(42)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("runs with the same seed differ at %d: %v != %v", i, first[i], second[i])
+		}
+	}
+	if _, err := uuid.Parse(first[0].(string)); err != nil {
+		t.Fatalf("ID is not a UUID: %v", err)
+	}
+	if other := run(43); other[0] == first[0] {
+		t.Fatal("runs with different seeds should differ")
+	}
+	if run(0)[0] == run(0)[0] {
+		t.Fatal("IDs should be random without a seed")
+	}
+
+	// the generated levels do not change the random numbers of the ticks
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	c.Game.Seed = 42
+	generated, other := NewGame(c), NewGame(c)
+	generated.generatorSeed()
+	if generated.NewId() != other.NewId() {
+		t.Fatal("generator seed should not be drawn from the game random source")
+	}
+}
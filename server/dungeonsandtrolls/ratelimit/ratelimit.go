@@ -0,0 +1,260 @@
+// Per API key limits of the request rate and of the concurrent blocking calls.
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Limits applied to every API key (zero disables the limit).
+type Limits struct {
+	RequestsPerSecond float64
+	Burst             int
+	// Blocking calls (waiting for the next tick) and streams running at the same time.
+	ConcurrentBlocking int
+	// Proxies whose x-forwarded-for is used to find the address of the calls without an API key (the gateway
+	// connects from the loopback). Other callers are identified by their own address.
+	TrustedProxies []*net.IPNet
+}
+
+// IdleTimeout is the time after which the state of a key which made no calls is forgotten.
+const IdleTimeout = 10 * time.Minute
+
+// KeyIdentifier returns a public ID of the API key (so the keys themselves are not kept).
+type KeyIdentifier interface {
+	ApiKeyId(apiKey string) (string, error)
+}
+
+// Usage of a single API key since its first call (after it was idle for the IdleTimeout).
+type Usage struct {
+	KeyId    string
+	Requests int64
+	Rejected int64
+	Blocking int
+}
+
+type keyState struct {
+	limiter  *rate.Limiter
+	usage    Usage
+	lastSeen time.Time
+}
+
+type Limiter struct {
+	limits Limits
+	ids    KeyIdentifier
+
+	lock       sync.Mutex
+	keys       map[string]*keyState
+	lastEvicts time.Time
+	now        func() time.Time
+}
+
+func New(limits Limits, ids KeyIdentifier) *Limiter {
+	return &Limiter{
+		limits: limits,
+		ids:    ids,
+		keys:   map[string]*keyState{},
+		now:    time.Now,
+	}
+}
+
+// keyId identifies the caller, calls without a (valid) API key are limited per address.
+func (l *Limiter) keyId(ctx context.Context) string {
+	token, err := auth.ApiKey(ctx)
+	if err == nil {
+		id, err := l.ids.ApiKeyId(token)
+		if err == nil {
+			return id
+		}
+	}
+	return "anonymous " + l.clientAddress(ctx)
+}
+
+func (l *Limiter) trusted(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, n := range l.limits.TrustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientAddress returns the peer address. The x-forwarded-for addresses (appended by each proxy) are followed only
+// through the trusted proxies, so the callers cannot pick their address.
+func (l *Limiter) clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	address := p.Addr.String()
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	var forwarded []string
+	for _, v := range md.Get("x-forwarded-for") {
+		for _, a := range strings.Split(v, ",") {
+			forwarded = append(forwarded, strings.TrimSpace(a))
+		}
+	}
+	for i := len(forwarded) - 1; i >= 0 && l.trusted(address); i-- {
+		address = forwarded[i]
+	}
+	return address
+}
+
+// evictIdle forgets the keys which made no calls for the IdleTimeout (checked at most once per the IdleTimeout).
+// The caller has to hold the lock.
+func (l *Limiter) evictIdle(now time.Time) {
+	if now.Sub(l.lastEvicts) < IdleTimeout {
+		return
+	}
+	l.lastEvicts = now
+	for id, s := range l.keys {
+		if s.usage.Blocking == 0 && now.Sub(s.lastSeen) >= IdleTimeout {
+			delete(l.keys, id)
+		}
+	}
+}
+
+func (l *Limiter) state(id string) *keyState {
+	s, ok := l.keys[id]
+	if !ok {
+		limit := rate.Inf
+		if l.limits.RequestsPerSecond > 0 {
+			limit = rate.Limit(l.limits.RequestsPerSecond)
+		}
+		burst := l.limits.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		s = &keyState{
+			limiter: rate.NewLimiter(limit, burst),
+			usage:   Usage{KeyId: id},
+		}
+		l.keys[id] = s
+	}
+	return s
+}
+
+// acquire counts the request and returns a function which has to be called once the request is done.
+func (l *Limiter) acquire(ctx context.Context, blocking bool) (func(), error) {
+	id := l.keyId(ctx)
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := l.now()
+	l.evictIdle(now)
+	s := l.state(id)
+	s.lastSeen = now
+	s.usage.Requests++
+
+	if blocking && l.limits.ConcurrentBlocking > 0 && s.usage.Blocking >= l.limits.ConcurrentBlocking {
+		s.usage.Rejected++
+		return nil, apierror.New(api.ErrorCode_RATE_LIMITED, "too many concurrent blocking calls (max %d)", l.limits.ConcurrentBlocking).
+			WithRetryDelay(time.Second)
+	}
+	r := s.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		s.usage.Rejected++
+		return nil, apierror.New(api.ErrorCode_RATE_LIMITED, "too many requests (max %g per second)", l.limits.RequestsPerSecond).
+			WithRetryDelay(delay)
+	}
+
+	if !blocking {
+		return func() {}, nil
+	}
+	s.usage.Blocking++
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		s.usage.Blocking--
+	}, nil
+}
+
+// isBlocking reports whether the request waits for the next tick (blocking is true when not set).
+func isBlocking(req interface{}) bool {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return false
+	}
+	r := m.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName("blocking")
+	if fd == nil {
+		return false
+	}
+	return !r.Has(fd) || r.Get(fd).Bool()
+}
+
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		done, err := l.acquire(ctx, isBlocking(req))
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor limits opening of the streams, every open stream counts as a blocking call.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		done, err := l.acquire(ss.Context(), true)
+		if err != nil {
+			return err
+		}
+		defer done()
+		return handler(srv, ss)
+	}
+}
+
+// Usage returns the usage of all the keys sorted by the key ID.
+func (l *Limiter) Usage() []Usage {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	var usage []Usage
+	for _, s := range l.keys {
+		usage = append(usage, s.usage)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].KeyId < usage[j].KeyId
+	})
+	return usage
+}
+
+// RetryAfterErrorHandler sets the Retry-After header of the gateway responses to the rejected requests.
+func RetryAfterErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if s, ok := status.FromError(err); ok {
+		for _, d := range s.Details() {
+			if ri, ok := d.(*errdetails.RetryInfo); ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(ri.RetryDelay.AsDuration().Seconds()))))
+			}
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+}
@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"go.openly.dev/pointy"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type keyIds map[string]string
+
+func (k keyIds) ApiKeyId(apiKey string) (string, error) {
+	id, ok := k[apiKey]
+	if !ok {
+		return "", fmt.Errorf("unknown key")
+	}
+	return id, nil
+}
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.ApiKeyFieldName, key))
+}
+
+func TestRequestsPerSecond(t *testing.T) {
+	l := New(Limits{RequestsPerSecond: 1, Burst: 2}, keyIds{"a": "key a", "b": "key b"})
+	for i := 0; i < 2; i++ {
+		if _, err := l.acquire(withKey("a"), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := l.acquire(withKey("a"), false)
+	if apierror.Code(err) != api.ErrorCode_RATE_LIMITED {
+		t.Fatalf("expected rate limited, got %v", err)
+	}
+	s, _ := status.FromError(err)
+	if s.Code() != codes.ResourceExhausted {
+		t.Fatalf("expected resource exhausted, got %s", s.Code())
+	}
+	retry := false
+	for _, d := range s.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok && ri.RetryDelay.AsDuration() > 0 {
+			retry = true
+		}
+	}
+	if !retry {
+		t.Fatal("retry delay missing")
+	}
+	if _, err := l.acquire(withKey("b"), false); err != nil {
+		t.Fatal("limits should be per key")
+	}
+
+	usage := l.Usage()
+	if len(usage) != 2 || usage[0].KeyId != "key a" || usage[0].Requests != 3 || usage[0].Rejected != 1 {
+		t.Fatalf("unexpected usage %v", usage)
+	}
+}
+
+func TestConcurrentBlocking(t *testing.T) {
+	l := New(Limits{ConcurrentBlocking: 1}, keyIds{"a": "key a"})
+	done, err := l.acquire(withKey("a"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.acquire(withKey("a"), true); apierror.Code(err) != api.ErrorCode_RATE_LIMITED {
+		t.Fatal("second blocking call allowed")
+	}
+	if _, err := l.acquire(withKey("a"), false); err != nil {
+		t.Fatal("non-blocking call should be allowed")
+	}
+	done()
+	if _, err := l.acquire(withKey("a"), true); err != nil {
+		t.Fatal("blocking call should be allowed after the first one finished")
+	}
+}
+
+func TestIsBlocking(t *testing.T) {
+	if !isBlocking(&api.GameStateParams{}) {
+		t.Fatal("blocking is the default")
+	}
+	if isBlocking(&api.GameStateParams{Blocking: pointy.Bool(false)}) {
+		t.Fatal("non-blocking request")
+	}
+	if isBlocking(&api.User{}) {
+		t.Fatal("request without the blocking field")
+	}
+}
+
+func fromAddress(address string, forwarded ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 1234}})
+	if len(forwarded) > 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwarded[0]))
+	}
+	return ctx
+}
+
+func TestAnonymousAddress(t *testing.T) {
+	_, loopback, _ := net.ParseCIDR("127.0.0.1/32")
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	l := New(Limits{TrustedProxies: []*net.IPNet{loopback, proxies}}, keyIds{})
+	for _, c := range []struct {
+		ctx     context.Context
+		address string
+	}{
+		{fromAddress("192.0.2.1"), "192.0.2.1"},
+		// only the trusted proxies can forward
+		{fromAddress("192.0.2.1", "198.51.100.1"), "192.0.2.1"},
+		// the gateway appends the address of its caller
+		{fromAddress("127.0.0.1", "192.0.2.1"), "192.0.2.1"},
+		{fromAddress("127.0.0.1", "198.51.100.1, 192.0.2.1"), "192.0.2.1"},
+		{fromAddress("127.0.0.1", "198.51.100.1, 10.0.0.1"), "198.51.100.1"},
+		{context.Background(), "unknown"},
+	} {
+		if id := l.keyId(c.ctx); id != "anonymous "+c.address {
+			t.Errorf("expected %s, got %s", c.address, id)
+		}
+	}
+}
+
+func TestEvictIdle(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := New(Limits{}, keyIds{"a": "key a", "b": "key b"})
+	l.now = func() time.Time { return now }
+	done, err := l.acquire(withKey("a"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.acquire(withKey("b"), false); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(IdleTimeout)
+	if _, err := l.acquire(fromAddress("192.0.2.1"), false); err != nil {
+		t.Fatal(err)
+	}
+	usage := l.Usage()
+	if len(usage) != 2 || usage[0].KeyId != "anonymous 192.0.2.1" || usage[1].KeyId != "key a" {
+		t.Fatalf("only the idle key should be evicted, got %v", usage)
+	}
+	done()
+}
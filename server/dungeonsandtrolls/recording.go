@@ -0,0 +1,392 @@
+package dungeonsandtrolls
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/replay"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// startRecording creates the replay file starting with the loaded game and the restored world (if any).
+func (g *Game) startRecording(path string, loaded bool, restored bool) error {
+	w, err := replay.Create(path)
+	if err != nil {
+		return err
+	}
+	h := &replay.Header{
+		Seed:    g.Config.Game.Seed,
+		Game:    g.Config.Game,
+		Balance: g.balance,
+	}
+	if loaded {
+		h.State, err = json.Marshal(g)
+		if err != nil {
+			return fmt.Errorf("replay header: %w", err)
+		}
+	}
+	if restored {
+		h.World, err = protojson.Marshal(g.snapshot())
+		if err != nil {
+			return fmt.Errorf("replay header: %w", err)
+		}
+	}
+	g.recorder = w
+	g.record(&replay.Record{Header: h})
+	log.Info().Msgf("Recording the game to %s", path)
+	return nil
+}
+
+func (g *Game) stopRecording() {
+	if g.recorder == nil {
+		return
+	}
+	if err := g.recorder.Close(); err != nil {
+		log.Warn().Err(err).Msg("Replay file was not closed")
+	}
+}
+
+func (g *Game) record(r *replay.Record) {
+	if g.recorder == nil {
+		return
+	}
+	r.Tick = g.Game.Tick
+	if err := g.recorder.Write(r); err != nil {
+		log.Warn().Err(err).Msg("Replay record was not written")
+	}
+}
+
+// recordInput records the commands queued for the tick. The caller has to hold the GameLock.
+func (g *Game) recordInput(balanceApplied bool) {
+	if g.recorder == nil {
+		return
+	}
+	in := &replay.Input{Commands: map[string]json.RawMessage{}}
+	g.CommandsLock.RLock()
+	for id, c := range g.Commands {
+		j, err := protojson.Marshal(c)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Commands of %s were not recorded", id)
+			continue
+		}
+		in.Commands[id] = j
+	}
+	g.CommandsLock.RUnlock()
+	for _, p := range g.Respawns {
+		in.Respawns = append(in.Respawns, p.GetId())
+	}
+	if balanceApplied {
+		b := g.balance
+		in.Balance = &b
+	}
+	g.record(&replay.Record{Input: in})
+}
+
+// recordOutput records the events and the state digest of the processed tick. The caller has to hold the GameLock.
+func (g *Game) recordOutput(tick int32) {
+	if g.recorder == nil {
+		return
+	}
+	out := &replay.Output{State: g.stateDigest()}
+	for _, e := range g.Game.Events {
+		j, err := protojson.Marshal(e)
+		if err != nil {
+			log.Warn().Err(err).Msg("Event was not recorded")
+			continue
+		}
+		out.Events = append(out.Events, j)
+	}
+	if err := g.recorder.Write(&replay.Record{Tick: tick, Output: out}); err != nil {
+		log.Warn().Err(err).Msg("Replay record was not written")
+	}
+}
+
+// recordAdmin records the request of the operator action. The caller has to hold the GameLock.
+func (g *Game) recordAdmin(method string, r proto.Message) {
+	if g.recorder == nil {
+		return
+	}
+	j, err := protojson.Marshal(r)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Admin %s was not recorded", method)
+		return
+	}
+	g.record(&replay.Record{Admin: &replay.Admin{Method: method, Request: j}})
+}
+
+// stateDigest is a hash of the map, the shop and the players (the events are compared separately).
+func (g *Game) stateDigest() string {
+	h := sha256.New()
+	opts := proto.MarshalOptions{Deterministic: true}
+	write := func(m proto.Message) {
+		b, err := opts.Marshal(m)
+		if err != nil {
+			log.Warn().Err(err).Msg("State digest is incomplete")
+		}
+		h.Write(b)
+	}
+	write(g.Game.Map)
+	for _, i := range g.Game.ShopItems {
+		write(i)
+	}
+	for _, p := range g.sortedPlayers() {
+		write(p.Character)
+	}
+	binary.Write(h, binary.LittleEndian, []int32{g.Game.Tick, g.MaxLevelReached})
+	binary.Write(h, binary.LittleEndian, math.Float32bits(g.Score))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// applyInput queues the recorded commands for the tick (which executes them as the live commands).
+func (g *Game) applyInput(in *replay.Input) error {
+	commands := map[string]*api.CommandsBatch{}
+	ids := maps.Keys(in.Commands)
+	slices.Sort(ids)
+	for _, id := range ids {
+		c := &api.CommandsBatch{}
+		if err := protojson.Unmarshal(in.Commands[id], c); err != nil {
+			return fmt.Errorf("commands of %s: %w", id, err)
+		}
+		commands[id] = c
+	}
+	g.Commands = commands
+
+	g.Respawns = []*gameobject.Player{}
+	for _, id := range in.Respawns {
+		p, err := g.getPlayerById(id)
+		if err != nil {
+			return fmt.Errorf("respawn: %w", err)
+		}
+		g.Respawns = append(g.Respawns, p)
+	}
+	if in.Balance != nil {
+		b := *in.Balance
+		g.pendingBalance = &b
+	}
+	return nil
+}
+
+// applyAdmin makes the recorded operator action. The errors are not reported, the recorded action failed the same way
+// (differences are found by the state digest).
+func (g *Game) applyAdmin(a *replay.Admin) error {
+	var r proto.Message
+	var apply func() error
+	switch a.Method {
+	case "KickPlayer":
+		kr := &api.KickPlayerRequest{}
+		r, apply = kr, func() error { return g.KickPlayer(kr.Id, kr.Ban) }
+	case "RespawnPlayer":
+		id := &api.Identifier{}
+		r, apply = id, func() error { return g.RespawnPlayer(id.Id) }
+	case "TeleportPlayer":
+		tr := &api.TeleportRequest{}
+		r, apply = tr, func() error { return g.TeleportPlayer(tr.Id, tr.Coordinates) }
+	case "RegenerateLevel":
+		lr := &api.LevelRequest{}
+		r, apply = lr, func() error { return g.RegenerateLevel(lr.Level) }
+	case "Grant":
+		gr := &api.GrantRequest{}
+		r, apply = gr, func() error { return g.Grant(gr) }
+	case "SetGameProgress":
+		gp := &api.GameProgress{}
+		r, apply = gp, func() error {
+			g.SetGameProgress(gp)
+			return nil
+		}
+	case "SpawnMonster":
+		sr := &api.SpawnMonsterRequest{}
+		r, apply = sr, func() error {
+			_, err := g.SpawnMonster(sr.Coordinates, sr.Monster)
+			return err
+		}
+	case "DropItem":
+		dr := &api.DropItemRequest{}
+		r, apply = dr, func() error {
+			_, err := g.DropItem(dr.Coordinates, dr.Item)
+			return err
+		}
+	case "SetTile":
+		tr := &api.SetTileRequest{}
+		r, apply = tr, func() error { return g.SetTile(tr.Coordinates, tr.Type) }
+	case "PlaceEffect":
+		er := &api.PlaceEffectRequest{}
+		r, apply = er, func() error { return g.PlaceEffect(er.Coordinates, er.Effect) }
+	default:
+		return fmt.Errorf("unknown admin method %s", a.Method)
+	}
+	if err := protojson.Unmarshal(a.Request, r); err != nil {
+		return fmt.Errorf("admin %s: %w", a.Method, err)
+	}
+	if err := apply(); err != nil {
+		log.Debug().Err(err).Msgf("Replayed admin %s failed", a.Method)
+	}
+	return nil
+}
+
+// checkOutput compares the processed tick with the recording. Lockstep reports are not replayed.
+func (g *Game) checkOutput(out *replay.Output) error {
+	var recorded []*api.Event
+	for _, j := range out.Events {
+		e := &api.Event{}
+		if err := protojson.Unmarshal(j, e); err != nil {
+			return fmt.Errorf("event: %w", err)
+		}
+		if e.GetType() != api.Event_MISSED_TICK {
+			recorded = append(recorded, e)
+		}
+	}
+	if len(recorded) != len(g.Game.Events) {
+		return fmt.Errorf("%d events recorded, %d replayed", len(recorded), len(g.Game.Events))
+	}
+	for i, e := range recorded {
+		if !proto.Equal(e, g.Game.Events[i]) {
+			return fmt.Errorf("event %d differs, recorded %v, replayed %v", i, e, g.Game.Events[i])
+		}
+	}
+	if out.State != g.stateDigest() {
+		return errors.New("game state differs")
+	}
+	return nil
+}
+
+// replayStart does what CreateGame does after the recording starts.
+func (g *Game) replayStart(loaded bool, restored bool) {
+	if loaded && !restored {
+		g.AddLevels(0, 0)
+		g.handleStoredPlayers()
+	}
+	if g.Config.Game.StepMode {
+		if _, err := g.GetCachedLevel(gameobject.ZeroLevel); err != nil {
+			g.AddLevel(gameobject.ZeroLevel)
+		}
+	}
+}
+
+// Replay runs the recorded game without the network and checks that every tick produces the recorded events and
+// state (including the changes made by the admin API). The replayed game is stored to the storage path. Returns the
+// number of the replayed ticks.
+func Replay(path string, storagePath string) (int, error) {
+	r, err := replay.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	rec, err := r.Next()
+	if err != nil {
+		return 0, err
+	}
+	if rec.Header == nil {
+		return 0, errors.New("replay file does not start with the header")
+	}
+
+	c := config.Default()
+	c.Storage.Path = storagePath
+	c.Game = rec.Header.Game
+	c.Game.Seed = rec.Header.Seed
+	// the reloaded balance is a part of the recording
+	c.Game.BalanceFile = ""
+	c.Game.Lockstep = false
+	c.Balance = rec.Header.Balance
+	g := NewGame(c)
+
+	var levels []*replay.Levels
+	var levelsErr error
+	g.generate = func(start int32, end int32, max int32, seed int64) string {
+		if len(levels) == 0 {
+			levelsErr = fmt.Errorf("levels %d-%d were generated but not recorded", start, end)
+			return `{"floors": []}`
+		}
+		l := levels[0]
+		levels = levels[1:]
+		if l.Start != start || l.End != end || l.MaxLevel != max || l.Seed != seed {
+			levelsErr = fmt.Errorf("levels %d-%d (max %d, seed %d) were generated instead of the recorded %d-%d (max %d, seed %d)",
+				start, end, max, seed, l.Start, l.End, l.MaxLevel, l.Seed)
+		}
+		return l.Output
+	}
+	// the levels are generated with the recorded seeds, the live game may have drawn them in a different order
+	// (the regenerated levels are recorded once they replace the old ones)
+	g.generatorSeeds = func() int64 {
+		if len(levels) == 0 {
+			return 0
+		}
+		return levels[0].Seed
+	}
+
+	g.Game.Tick = rec.Tick
+	g.changesStart = rec.Tick
+	loaded := len(rec.Header.State) > 0
+	if loaded {
+		if err := json.Unmarshal(rec.Header.State, g); err != nil {
+			return 0, fmt.Errorf("replay header: %w", err)
+		}
+	}
+	restored := len(rec.Header.World) > 0
+	if restored {
+		world := &api.WorldSnapshot{}
+		if err := protojson.Unmarshal(rec.Header.World, world); err != nil {
+			return 0, fmt.Errorf("replay header: %w", err)
+		}
+		g.restoreWorld(world)
+	}
+
+	ticks := 0
+	started := false
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return ticks, nil
+		}
+		if err != nil {
+			return ticks, err
+		}
+		// the levels generated when the game is created follow the header
+		if !started && rec.Levels == nil {
+			g.replayStart(loaded, restored)
+			started = true
+		}
+		switch {
+		case rec.Levels != nil:
+			levels = append(levels, rec.Levels)
+		case rec.Player != nil:
+			p := gameobject.CreatePlayer(g.NewId(), rec.Player.Name, g.Balance())
+			if p.GetId() != rec.Player.Id {
+				return ticks, fmt.Errorf("tick %d: player %s got the ID %s instead of the recorded %s", rec.Tick, rec.Player.Name, p.GetId(), rec.Player.Id)
+			}
+			g.AddPlayer(p)
+		case rec.Input != nil:
+			if err := g.applyInput(rec.Input); err != nil {
+				return ticks, fmt.Errorf("tick %d: %w", rec.Tick, err)
+			}
+		case rec.Admin != nil:
+			if err := g.applyAdmin(rec.Admin); err != nil {
+				return ticks, fmt.Errorf("tick %d: %w", rec.Tick, err)
+			}
+		case rec.Output != nil:
+			if rec.Tick != g.Game.Tick {
+				return ticks, fmt.Errorf("tick %d was recorded instead of %d", rec.Tick, g.Game.Tick)
+			}
+			g.tick()
+			if levelsErr != nil {
+				return ticks, fmt.Errorf("tick %d: %w", rec.Tick, levelsErr)
+			}
+			if err := g.checkOutput(rec.Output); err != nil {
+				return ticks, fmt.Errorf("tick %d: %w", rec.Tick, err)
+			}
+			ticks++
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package dungeonsandtrolls
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"go.openly.dev/pointy"
+)
+
+// testGeneratorOutput is a single free level of the given size with a spawn in the corner.
+func testGeneratorOutput(size int) string {
+	var tiles []map[string]interface{}
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			t := "decoration"
+			if x == 0 && y == 0 {
+				t = "spawn"
+			}
+			tiles = append(tiles, map[string]interface{}{"x": x, "y": y, "type": t})
+		}
+	}
+	j, _ := json.Marshal(map[string]interface{}{
+		"floors": []interface{}{map[string]interface{}{"level": 0, "width": size, "height": size, "tiles": tiles}},
+	})
+	return string(j)
+}
+
+// newTestGame creates a game stored in a temporary directory which generates the test levels.
+func newTestGame(t *testing.T) *Game {
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	g := NewGame(c)
+	g.generate = func(start int32, end int32, max int32, seed int64) string {
+		return testGeneratorOutput(5)
+	}
+	return g
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	c.Game.Seed = 7
+	path := filepath.Join(t.TempDir(), "replay.jsonl")
+	g := NewGame(c)
+	g.generate = func(start int32, end int32, max int32, seed int64) string {
+		return testGeneratorOutput(5)
+	}
+	if err := g.startRecording(path, false, false); err != nil {
+		t.Fatal(err)
+	}
+
+	var players []*gameobject.Player
+	for tick := 0; tick < 5; tick++ {
+		if tick == 1 || tick == 2 {
+			// registered the way the handler does, the API key is not replayed
+			p := gameobject.CreatePlayer(g.NewId(), fmt.Sprintf("player %d", tick), g.Balance())
+			g.AddPlayer(p)
+			g.AddApiKey(GenerateApiKey(), p.GetName(), []auth.Role{auth.RolePlayer}, "")
+			players = append(players, p)
+		}
+		for i, p := range players {
+			pc := g.GetCommands(p.GetId())
+			pc.Yell = &api.Message{Text: fmt.Sprintf("tick %d", tick)}
+			pos := &api.Position{PositionX: int32(tick % 5), PositionY: int32(i + 1)}
+			// queued the way the handler does, the tick sets the path
+			if err := g.ValidateMove(p, pos); err != nil {
+				t.Fatal(err)
+			}
+			pc.Move = pos
+		}
+		if tick == 3 {
+			// operator actions are replayed too
+			if err := g.Grant(&api.GrantRequest{Id: players[0].GetId(), Money: pointy.Int32(100)}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := g.DropItem(&api.Coordinates{PositionX: 4, PositionY: 4}, &api.Item{Name: "dropped"}); err != nil {
+				t.Fatal(err)
+			}
+			// the tick goes on while the level is regenerated
+			generate := g.generate
+			g.generate = func(start int32, end int32, max int32, seed int64) string {
+				g.generate = generate
+				g.tick()
+				return generate(start, end, max, seed)
+			}
+			if err := g.RegenerateLevel(gameobject.ZeroLevel); err != nil {
+				t.Fatal(err)
+			}
+		}
+		g.tick()
+	}
+	g.stopRecording()
+
+	ticks, err := Replay(path, t.TempDir())
+	if err != nil || ticks != 6 {
+		t.Fatalf("all ticks should be replayed, %d: %v", ticks, err)
+	}
+
+	// a changed command changes the replayed events
+	j, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(strings.Replace(string(j), "tick 3", "tick X", 1)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if ticks, err = Replay(path, t.TempDir()); err == nil || ticks != 3 {
+		t.Fatalf("replay should diverge in the tick 3, %d: %v", ticks, err)
+	}
+}
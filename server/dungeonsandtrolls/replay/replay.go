@@ -0,0 +1,169 @@
+// Replay files with everything needed to run the game again without the network. Every line is a JSON record.
+
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+)
+
+// Record is a single line of the replay file, exactly one of the parts is set.
+type Record struct {
+	// tick in which the record was made
+	Tick   int32   `json:"tick"`
+	Header *Header `json:"header,omitempty"`
+	Levels *Levels `json:"levels,omitempty"`
+	Player *Player `json:"player,omitempty"`
+	Input  *Input  `json:"input,omitempty"`
+	Output *Output `json:"output,omitempty"`
+	Admin  *Admin  `json:"admin,omitempty"`
+}
+
+// Header is the first record describing the game the recording started from.
+type Header struct {
+	Seed    int64          `json:"seed"`
+	Game    config.Game    `json:"game"`
+	Balance config.Balance `json:"balance"`
+	// stored game state (missing on a fresh start)
+	State json.RawMessage `json:"state,omitempty"`
+	// restored world (api.WorldSnapshot), the levels are generated again without it
+	World json.RawMessage `json:"world,omitempty"`
+}
+
+// Levels is the output of the generator.
+type Levels struct {
+	Start    int32  `json:"start"`
+	End      int32  `json:"end"`
+	MaxLevel int32  `json:"max_level"`
+	Seed     int64  `json:"seed"`
+	Output   string `json:"output"`
+}
+
+// Player was registered.
+type Player struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Input of the tick recorded before it is processed.
+type Input struct {
+	// queued commands (api.CommandsBatch) by the issuer IDs, accepted moves are included
+	Commands map[string]json.RawMessage `json:"commands"`
+	// IDs of the players requesting a respawn
+	Respawns []string `json:"respawns,omitempty"`
+	// balance reloaded for the tick
+	Balance *config.Balance `json:"balance,omitempty"`
+}
+
+// Admin is a change made by an operator between the ticks.
+type Admin struct {
+	// name of the admin API method
+	Method string `json:"method"`
+	// request of the method (as received, before the IDs were assigned)
+	Request json.RawMessage `json:"request"`
+}
+
+// Output of the processed tick.
+type Output struct {
+	// produced events (api.Event)
+	Events []json.RawMessage `json:"events"`
+	// digest of the game state after the tick
+	State string `json:"state"`
+}
+
+// Writer appends the records to the replay file.
+type Writer struct {
+	lock sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+}
+
+// Name adds the start time of the recording to the file name (replay.jsonl becomes replay-20060102-150405.jsonl), so
+// every run of the server is recorded to a new file.
+func Name(path string, started time.Time) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "-" + started.Format("20060102-150405") + ext
+}
+
+// Create creates a new replay file, existing files are never overwritten.
+func Create(path string) (*Writer, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("replay file: %w", err)
+	}
+	return &Writer{file: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Write appends the record, the records of a tick are flushed with its output.
+func (w *Writer) Write(r *Record) error {
+	j, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file == nil {
+		return errors.New("replay file is closed")
+	}
+	if _, err := w.w.Write(append(j, '\n')); err != nil {
+		return err
+	}
+	if r.Output != nil || r.Header != nil {
+		return w.w.Flush()
+	}
+	return nil
+}
+
+func (w *Writer) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.w.Flush()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	w.file = nil
+	return err
+}
+
+// Reader reads the records of the replay file.
+type Reader struct {
+	file *os.File
+	d    *json.Decoder
+}
+
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("replay file: %w", err)
+	}
+	return &Reader{file: f, d: json.NewDecoder(bufio.NewReader(f))}, nil
+}
+
+// Next returns the next record or io.EOF at the end of the file.
+func (r *Reader) Next() (*Record, error) {
+	rec := &Record{}
+	if err := r.d.Decode(rec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("replay file: %w", err)
+	}
+	return rec, nil
+}
+
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
@@ -0,0 +1,208 @@
+package dungeonsandtrolls
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/rs/zerolog/log"
+	"github.com/solarlune/paths"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const worldStorageKey = "world"
+
+// snapshot captures the whole world (the levels, the monsters, the players and the pending commands). The snapshot
+// shares the objects with the game, the caller has to hold the GameLock until it is serialized.
+func (g *Game) snapshot() *api.WorldSnapshot {
+	s := &api.WorldSnapshot{
+		Tick:      g.Game.Tick,
+		ShopItems: g.Game.ShopItems,
+		Commands:  map[string]*api.CommandsBatch{},
+	}
+	for _, l := range g.Game.Map.Levels {
+		ls := &api.LevelSnapshot{Level: l}
+		if lc, err := g.mapCache.CachedLevel(l.Level); err == nil {
+			ls.GeneratedTick = lc.GeneratedTick
+			ls.LastInteractedTick = lc.LastInteractedTick
+			ls.SpawnPoint = lc.SpawnPoint
+		}
+		s.Levels = append(s.Levels, ls)
+	}
+	for _, o := range g.sortedObjects() {
+		if m, ok := o.(*gameobject.Monster); ok {
+			ms := &api.MonsterSnapshot{
+				Id:           m.GetId(),
+				Position:     m.GetPosition(),
+				KillCounter:  m.KillCounter,
+				MovingTo:     pathSnapshot(m.GetMovingTo()),
+				DamageLedger: m.DamageLedger.Records,
+			}
+			if m.SummonedBy != "" {
+				ms.SummonedBy = &m.SummonedBy
+			}
+			s.Monsters = append(s.Monsters, ms)
+		}
+	}
+	for _, p := range g.sortedPlayers() {
+		s.Players = append(s.Players, &api.PlayerSnapshot{
+			Character:      p.Character,
+			BaseAttributes: p.BaseAttributes,
+			MovingTo:       pathSnapshot(p.GetMovingTo()),
+			DamageLedger:   p.DamageLedger.Records,
+		})
+	}
+	g.CommandsLock.RLock()
+	for id, c := range g.Commands {
+		s.Commands[id] = c
+	}
+	g.CommandsLock.RUnlock()
+	for _, p := range g.Respawns {
+		s.Respawns = append(s.Respawns, p.GetId())
+	}
+	return s
+}
+
+func pathSnapshot(p *paths.Path) *api.PathSnapshot {
+	if p == nil {
+		return nil
+	}
+	ps := &api.PathSnapshot{CurrentIndex: int32(p.CurrentIndex)}
+	for _, c := range p.Cells {
+		ps.Cells = append(ps.Cells, &api.Position{PositionX: int32(c.X), PositionY: int32(c.Y)})
+	}
+	return ps
+}
+
+// restorePath finds the cells of the stored path in the grid of the level the character is on.
+func (g *Game) restorePath(ps *api.PathSnapshot, c *api.Coordinates) *paths.Path {
+	if ps == nil || c == nil {
+		return nil
+	}
+	lc, err := g.mapCache.CachedLevel(c.Level)
+	if err != nil {
+		return nil
+	}
+	p := &paths.Path{CurrentIndex: int(ps.CurrentIndex)}
+	for _, pos := range ps.Cells {
+		cell := lc.Grid.Get(int(pos.PositionX), int(pos.PositionY))
+		if cell == nil {
+			return nil
+		}
+		p.Cells = append(p.Cells, cell)
+	}
+	return p
+}
+
+// storeWorld queues a copy of the world snapshot to the storage (it is serialized by the storage writer). The caller
+// has to hold the GameLock.
+func (g *Game) storeWorld() {
+	if err := g.stores.snapshots.WriteProto(worldStorageKey, proto.Clone(g.snapshot())); err != nil {
+		log.Warn().Err(err).Msg("World snapshot was not stored")
+	}
+}
+
+// loadWorld reads the world snapshot stored together with the current game tick.
+func (g *Game) loadWorld() (*api.WorldSnapshot, error) {
+	var j json.RawMessage
+	if err := g.stores.snapshots.ReadTo(worldStorageKey, &j); err != nil {
+		return nil, err
+	}
+	s := &api.WorldSnapshot{}
+	if err := protojson.Unmarshal(j, s); err != nil {
+		return nil, fmt.Errorf("world snapshot: %w", err)
+	}
+	if s.Tick != g.Game.Tick {
+		return nil, fmt.Errorf("world snapshot is from the tick %d instead of %d", s.Tick, g.Game.Tick)
+	}
+	return s, nil
+}
+
+// restoreWorld replaces the world by the snapshot, the stored players are replaced by the snapshot ones (the legacy
+// admin flag is kept).
+func (g *Game) restoreWorld(s *api.WorldSnapshot) {
+	players := map[string]*gameobject.Player{}
+	characters := map[string]*api.Character{}
+	for _, ps := range s.Players {
+		p := gameobject.RestorePlayer(ps.Character, ps.BaseAttributes)
+		if stored, ok := g.Players[p.GetName()]; ok {
+			p.IsAdmin = stored.IsAdmin
+		}
+		players[p.GetName()] = p
+		characters[p.GetId()] = p.Character
+	}
+	g.Players = players
+
+	monsters := map[string]*api.Monster{}
+	g.Game.Map.Levels = []*api.Level{}
+	for _, ls := range s.Levels {
+		l := ls.Level
+		for _, o := range l.Objects {
+			// the tiles reference the same characters as the players
+			for i, c := range o.Players {
+				if pc, ok := characters[c.GetId()]; ok {
+					o.Players[i] = pc
+				} else {
+					log.Warn().Msgf("Unknown player %s (%s) on the level %d", c.GetId(), c.GetName(), l.Level)
+				}
+			}
+			for _, i := range o.Items {
+				g.Register(i)
+			}
+			for _, m := range o.Monsters {
+				monsters[m.GetId()] = m
+			}
+		}
+		lc, err := g.mapCache.cacheLevel(l, ls.GeneratedTick)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Level %d was not restored", l.Level)
+			continue
+		}
+		lc.LastInteractedTick = ls.LastInteractedTick
+		if ls.SpawnPoint != nil {
+			lc.SpawnPoint = ls.SpawnPoint
+		}
+		g.Game.Map.Levels = append(g.Game.Map.Levels, l)
+	}
+	g.SortMaps()
+
+	for _, ms := range s.Monsters {
+		mon, ok := monsters[ms.Id]
+		if !ok {
+			log.Warn().Msgf("Monster %s is not on any restored level", ms.Id)
+			continue
+		}
+		m := gameobject.RestoreMonster(mon, ms.Position, ms.KillCounter)
+		m.SetMovingTo(g.restorePath(ms.MovingTo, ms.Position))
+		m.SummonedBy = ms.GetSummonedBy()
+		m.DamageLedger.Records = ms.DamageLedger
+		g.Register(m)
+	}
+	g.Game.ShopItems = []*api.Item{}
+	for _, i := range s.ShopItems {
+		g.AddItem(i)
+	}
+	for _, ps := range s.Players {
+		p := players[ps.Character.GetName()]
+		p.SetMovingTo(g.restorePath(ps.MovingTo, p.GetPosition()))
+		p.DamageLedger.Records = ps.DamageLedger
+		g.Register(p)
+	}
+
+	g.Commands = map[string]*api.CommandsBatch{}
+	for id, c := range s.Commands {
+		g.Commands[id] = c
+	}
+	g.Respawns = []*gameobject.Player{}
+	for _, id := range s.Respawns {
+		p, err := g.getPlayerById(id)
+		if err != nil {
+			log.Warn().Err(err).Msg("Respawn was not restored")
+			continue
+		}
+		g.Respawns = append(g.Respawns, p)
+	}
+	log.Info().Msgf("World restored with %d levels, %d monsters and %d players", len(g.Game.Map.Levels), len(s.Monsters), len(players))
+}
@@ -0,0 +1,95 @@
+package dungeonsandtrolls
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"go.openly.dev/pointy"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRestoreWorld(t *testing.T) {
+	c := config.Default()
+	c.Storage.Path = t.TempDir()
+	c.Storage.Backend = "bolt"
+	c.Game.StepMode = true
+	g := NewGame(c)
+	g.generate = func(start int32, end int32, max int32, seed int64) string {
+		return testGeneratorOutput(5)
+	}
+	g.Score = 5
+	g.AddLevel(gameobject.ZeroLevel)
+
+	p := gameobject.CreatePlayer(g.NewId(), "player", g.Balance())
+	g.AddPlayer(p)
+	summon := &api.Droppable{Data: &api.Droppable_Monster{Monster: &api.Monster{Name: "imp"}}}
+	err := p.Equip(&api.Item{
+		Id:         g.NewId(),
+		Slot:       api.Item_mainHand,
+		Attributes: &api.Attributes{Strength: pointy.Float32(2)},
+		Skills:     []*api.Skill{{Id: g.NewId(), TargetEffects: &api.SkillEffect{Summons: []*api.Droppable{summon}}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Character.Stun.IsStunned = true
+	g.GetCommands(p.GetId()).Yell = &api.Message{Text: "hello"}
+
+	pos := &api.Coordinates{PositionX: 3, PositionY: 3}
+	mon := &api.Monster{Id: g.NewId(), Name: "troll", Attributes: &api.Attributes{Life: pointy.Float32(10)}, Stun: &api.Stun{}}
+	o := g.GetMapObjectsOrCreateDefault(pos)
+	o.Monsters = append(o.Monsters, mon)
+	m := gameobject.CreateMonster(mon, pos)
+	m.KillCounter = pointy.Int32(3)
+	m.SummonedBy = p.GetId()
+	m.DamageLedger.Record(p.GetId(), 4, g.Game.Tick, assistWindow)
+	g.Register(m)
+	if err := g.MoveTo(m, &api.Position{PositionX: 0, PositionY: 4}); err != nil {
+		t.Fatal(err)
+	}
+	g.storeGameState()
+	// waits for the writes
+	g.stores.close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	restored, err := CreateGame(ctx, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		cancel()
+		<-restored.Done()
+	}()
+	if !proto.Equal(g.snapshot(), restored.snapshot()) {
+		t.Fatal("restored world differs")
+	}
+	if restored.Score != g.Score {
+		t.Fatalf("score %f should be restored", restored.Score)
+	}
+
+	rp, err := restored.getPlayerById(p.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rp.Equipped) != 1 || len(rp.Skills) != 1 || !rp.IsStunned() {
+		t.Fatal("equipment, skills and stun of the player should be restored")
+	}
+	ro, err := restored.GetObjectsOnPosition(rp.GetPosition())
+	if err != nil || len(ro.Players) != 1 || ro.Players[0] != rp.Character {
+		t.Fatal("tile should reference the restored player")
+	}
+	rmo, err := restored.GetObjectById(mon.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rm := rmo.(*gameobject.Monster)
+	if *rm.KillCounter != 3 || rm.GetMovingTo() == nil || rm.GetMovingTo().Length() != m.GetMovingTo().Length() {
+		t.Fatal("kill counter and path of the monster should be restored")
+	}
+	if rm.GetMovingTo().Current() != restored.mapCache.Level[0].Grid.Get(3, 3) {
+		t.Fatal("path should use the cells of the restored grid")
+	}
+}
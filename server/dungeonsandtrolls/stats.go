@@ -0,0 +1,333 @@
+package dungeonsandtrolls
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/storage"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/utils"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// statsPeriod is the number of ticks the recent all time stats are collected together.
+const statsPeriod = 60
+
+// statsPeriods are the lengths of the stats periods. The periods are compacted to the next longer ones once they are
+// older than a whole longer period (an hour and a day of the shortest periods), so only a few periods are kept for the
+// older stats. The time windows of the leaderboard are rounded to the periods.
+var statsPeriods = []int32{statsPeriod, 60 * statsPeriod, 24 * 60 * statsPeriod}
+
+// statsPeriodLength returns the length of the period containing the tick when the stats are compacted at the tick now.
+func statsPeriodLength(tick int32, now int32) int32 {
+	length := statsPeriods[0]
+	for _, l := range statsPeriods[1:] {
+		if tick < now-now%l-l {
+			length = l
+		}
+	}
+	return length
+}
+
+// statsPeriodOf returns the first tick of the period containing the tick when the stats are compacted at the tick now.
+func statsPeriodOf(tick int32, now int32) int32 {
+	return tick - tick%statsPeriodLength(tick, now)
+}
+
+// startRun resets the stats of the current life of the player.
+func (g *Game) startRun(p *gameobject.Player) {
+	g.runs[p.GetName()] = &api.PlayerStats{Name: p.GetName(), SinceTick: g.Game.Tick}
+}
+
+// countStats adds the stats to the current life, to the current period and to the profile of the player, so the
+// leaderboard and the profile always agree. The objects which are not players are ignored.
+func (g *Game) countStats(id string, s *api.PlayerStats) {
+	o, err := g.GetObjectById(id)
+	if err != nil {
+		return
+	}
+	p, ok := o.(*gameobject.Player)
+	if !ok {
+		return
+	}
+	run, ok := g.runs[p.GetName()]
+	if !ok {
+		run = &api.PlayerStats{Name: p.GetName(), SinceTick: g.Game.Tick}
+		g.runs[p.GetName()] = run
+	}
+	period := statsPeriodOf(g.Game.Tick, g.Game.Tick)
+	if _, ok := g.stats[period]; !ok {
+		g.stats[period] = map[string]*api.PlayerStats{}
+	}
+	ps, ok := g.stats[period][p.GetName()]
+	if !ok {
+		ps = &api.PlayerStats{Name: p.GetName(), SinceTick: period}
+		g.stats[period][p.GetName()] = ps
+	}
+	addStats(run, s)
+	addStats(ps, s)
+
+	pr := g.profile(p.GetName())
+	pr.DeepestLevel = utils.Max(pr.DeepestLevel, s.DeepestLevel)
+	pr.Kills += s.Kills
+	pr.TicksAlive += s.TicksAlive
+	pr.DamageDealt += s.DamageDealt
+	pr.Assists += s.Assists
+}
+
+// recordStats collects the stats from the events of the tick and from the players in the game. The caller has to hold
+// the GameLock.
+func (g *Game) recordStats() {
+	for _, e := range g.Game.Events {
+		switch e.GetType() {
+		case api.Event_DAMAGE:
+			g.countStats(e.GetCasterId(), &api.PlayerStats{DamageDealt: e.GetDamage()})
+		case api.Event_DEATH:
+			// the deaths of the monsters and of the players are credited alike
+			for _, c := range e.Credits {
+				if c.KillingBlow {
+					g.countStats(c.Id, &api.PlayerStats{Kills: 1})
+				} else {
+					g.countStats(c.Id, &api.PlayerStats{Assists: 1})
+				}
+			}
+		case api.Event_SCORE:
+			for _, c := range e.Credits {
+				g.countStats(c.Id, &api.PlayerStats{Score: c.GetScore()})
+			}
+		}
+	}
+	for _, p := range g.sortedPlayers() {
+		if p.GetPosition() == nil {
+			continue
+		}
+		g.countStats(p.GetId(), &api.PlayerStats{TicksAlive: 1, DeepestLevel: p.GetPosition().Level})
+	}
+	g.compactStats(g.Game.Tick)
+}
+
+// compactStats merges the periods which became too old to the longer ones, see statsPeriods.
+func (g *Game) compactStats(now int32) {
+	for period, stats := range g.stats {
+		compacted := statsPeriodOf(period, now)
+		if compacted == period {
+			continue
+		}
+		if _, ok := g.stats[compacted]; !ok {
+			g.stats[compacted] = map[string]*api.PlayerStats{}
+		}
+		for name, s := range stats {
+			to, ok := g.stats[compacted][name]
+			if !ok {
+				to = &api.PlayerStats{Name: name, SinceTick: compacted}
+				g.stats[compacted][name] = to
+			}
+			addStats(to, s)
+		}
+		delete(g.stats, period)
+		g.changedStats[period] = true
+		g.changedStats[compacted] = true
+	}
+}
+
+func addStats(to *api.PlayerStats, s *api.PlayerStats) {
+	to.DeepestLevel = utils.Max(to.DeepestLevel, s.DeepestLevel)
+	to.Kills += s.Kills
+	to.Score += s.Score
+	to.TicksAlive += s.TicksAlive
+	to.DamageDealt += s.DamageDealt
+	to.Assists += s.Assists
+}
+
+func statsValue(s *api.PlayerStats, m api.LeaderboardRequest_Measure) (float32, error) {
+	switch m {
+	case api.LeaderboardRequest_DEEPEST_LEVEL:
+		return float32(s.DeepestLevel), nil
+	case api.LeaderboardRequest_KILLS:
+		return float32(s.Kills), nil
+	case api.LeaderboardRequest_SCORE:
+		return s.Score, nil
+	case api.LeaderboardRequest_SURVIVAL:
+		return float32(s.TicksAlive), nil
+	case api.LeaderboardRequest_DAMAGE_DEALT:
+		return s.DamageDealt, nil
+	case api.LeaderboardRequest_ASSISTS:
+		return float32(s.Assists), nil
+	}
+	return 0, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "unknown measure %s", m)
+}
+
+// Leaderboard ranks the players by the measure.
+func (g *Game) Leaderboard(r *api.LeaderboardRequest) (*api.Ranking, error) {
+	if r.GetLimit() < 0 {
+		return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "limit must not be negative")
+	}
+	g.GameLock.RLock()
+	defer g.GameLock.RUnlock()
+
+	ranking := &api.Ranking{}
+	var stats []*api.PlayerStats
+	switch r.GetScope() {
+	case api.LeaderboardRequest_RUN:
+		if r.SinceTick != nil || r.UntilTick != nil {
+			return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "time window applies only to the all time stats")
+		}
+		for name := range g.Players {
+			if run, ok := g.runs[name]; ok {
+				stats = append(stats, run)
+			}
+		}
+	case api.LeaderboardRequest_ALL_TIME:
+		// the stats were compacted in the last processed tick
+		now := g.Game.Tick - 1
+		since := statsPeriodOf(utils.Max(r.GetSinceTick(), 0), now)
+		until := g.Game.Tick
+		if r.UntilTick != nil && r.GetUntilTick() < until {
+			until = statsPeriodOf(r.GetUntilTick(), now) + statsPeriodLength(r.GetUntilTick(), now) - 1
+		}
+		if until < since {
+			return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "time window ends before it starts")
+		}
+		ranking.SinceTick = &since
+		ranking.UntilTick = &until
+		merged := map[string]*api.PlayerStats{}
+		for period, periodStats := range g.stats {
+			if period < since || period > until {
+				continue
+			}
+			for name, s := range periodStats {
+				m, ok := merged[name]
+				if !ok {
+					m = &api.PlayerStats{Name: name, SinceTick: period}
+					merged[name] = m
+				}
+				addStats(m, s)
+				if period < m.SinceTick {
+					m.SinceTick = period
+				}
+			}
+		}
+		stats = maps.Values(merged)
+	default:
+		return nil, apierror.New(api.ErrorCode_INVALID_ARGUMENT, "unknown scope %s", r.GetScope())
+	}
+
+	for _, s := range stats {
+		v, err := statsValue(s, r.GetMeasure())
+		if err != nil {
+			return nil, err
+		}
+		ranking.Entries = append(ranking.Entries, &api.Ranking_Entry{Name: s.Name, Value: v})
+	}
+	sort.Slice(ranking.Entries, func(i, j int) bool {
+		a, b := ranking.Entries[i], ranking.Entries[j]
+		if a.Value != b.Value {
+			return a.Value > b.Value
+		}
+		return a.Name < b.Name
+	})
+	for i, e := range ranking.Entries {
+		e.Rank = int32(i + 1)
+		if i > 0 && ranking.Entries[i-1].Value == e.Value {
+			e.Rank = ranking.Entries[i-1].Rank
+		}
+	}
+	if r.Limit != nil && int(r.GetLimit()) < len(ranking.Entries) {
+		ranking.Entries = ranking.Entries[:r.GetLimit()]
+	}
+	return ranking, nil
+}
+
+func readStats(s storage.Store, key string) (map[string]*api.PlayerStats, error) {
+	var j map[string]json.RawMessage
+	if err := s.ReadTo(key, &j); err != nil {
+		return nil, err
+	}
+	stats := map[string]*api.PlayerStats{}
+	for name, v := range j {
+		ps := &api.PlayerStats{}
+		if err := protojson.Unmarshal(v, ps); err != nil {
+			return nil, fmt.Errorf("stats of %s: %w", name, err)
+		}
+		stats[name] = ps
+	}
+	return stats, nil
+}
+
+func statsJson(stats map[string]*api.PlayerStats) (map[string]json.RawMessage, error) {
+	j := map[string]json.RawMessage{}
+	for name, ps := range stats {
+		v, err := protojson.Marshal(ps)
+		if err != nil {
+			return nil, fmt.Errorf("stats of %s: %w", name, err)
+		}
+		j[name] = v
+	}
+	return j, nil
+}
+
+func (g *Game) loadStats() error {
+	periods, err := g.stores.stats.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range periods {
+		period, err := strconv.Atoi(key)
+		if err != nil {
+			return fmt.Errorf("stats period %s: %w", key, err)
+		}
+		g.stats[int32(period)], err = readStats(g.stores.stats, key)
+		if err != nil {
+			return fmt.Errorf("stats period %s: %w", key, err)
+		}
+	}
+	// stored before the periods were compacted
+	g.compactStats(g.Game.Tick - 1)
+	runs, err := readStats(g.stores.scores, runsKey)
+	if err != nil {
+		// stored before the runs were tracked
+		log.Info().Err(err).Msg("Runs were not loaded")
+		return nil
+	}
+	g.runs = runs
+	return nil
+}
+
+// storeStats queues the runs, the stats of the last processed tick and the compacted periods to the storage (the
+// other periods do not change). The caller has to hold the GameLock.
+func (g *Game) storeStats() {
+	runs, err := statsJson(g.runs)
+	if err == nil {
+		err = g.stores.scores.Write(runsKey, runs)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msg("Runs were not stored")
+	}
+	if period := statsPeriodOf(g.Game.Tick-1, g.Game.Tick-1); g.stats[period] != nil {
+		g.changedStats[period] = true
+	}
+	for period := range g.changedStats {
+		key := fmt.Sprintf("%010d", period)
+		stats, ok := g.stats[period]
+		if !ok {
+			if err := g.stores.stats.Delete(key); err != nil {
+				log.Warn().Err(err).Msg("Compacted stats were not deleted")
+			}
+			continue
+		}
+		j, err := statsJson(stats)
+		if err == nil {
+			err = g.stores.stats.Write(key, j)
+		}
+		if err != nil {
+			log.Warn().Err(err).Msg("Stats were not stored")
+		}
+	}
+	g.changedStats = map[int32]bool{}
+}
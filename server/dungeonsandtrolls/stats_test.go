@@ -0,0 +1,133 @@
+package dungeonsandtrolls
+
+import (
+	"testing"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"go.openly.dev/pointy"
+)
+
+func TestLeaderboard(t *testing.T) {
+	g := newTestGame(t)
+	alice := gameobject.CreatePlayer(g.NewId(), "alice", g.Balance())
+	g.AddPlayer(alice)
+	bob := gameobject.CreatePlayer(g.NewId(), "bob", g.Balance())
+	g.AddPlayer(bob)
+
+	deathEvent := api.Event_DEATH
+	scoreEvent := api.Event_SCORE
+	damageEvent := api.Event_DAMAGE
+	g.Game.Events = []*api.Event{
+		{Type: &damageEvent, CasterId: pointy.String(alice.GetId()), Damage: pointy.Float32(5)},
+		{Type: &deathEvent, KillerId: pointy.String(alice.GetId()), Credits: []*api.KillCredit{
+			{Id: alice.GetId(), Share: 0.8, KillingBlow: true},
+			{Id: bob.GetId(), Share: 0.2},
+		}},
+		{Type: &scoreEvent, Score: pointy.Float32(10), Credits: []*api.KillCredit{
+			{Id: alice.GetId(), Share: 0.8, KillingBlow: true, Score: pointy.Float32(8)},
+			{Id: bob.GetId(), Share: 0.2, Score: pointy.Float32(2)},
+		}},
+		// player deaths are counted as kills too
+		{Type: &deathEvent, VictimId: pointy.String(alice.GetId()), KillerId: pointy.String(bob.GetId()), Credits: []*api.KillCredit{
+			{Id: bob.GetId(), Share: 1, KillingBlow: true},
+		}},
+	}
+	g.recordStats()
+	g.Game.Tick = statsPeriod
+	g.Game.Events = nil
+	g.Respawn(alice, true)
+	g.recordStats()
+
+	r, err := g.Leaderboard(&api.LeaderboardRequest{Measure: api.LeaderboardRequest_SCORE, Scope: api.LeaderboardRequest_ALL_TIME})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Entries) != 2 || r.Entries[0].Name != "alice" || r.Entries[0].Value != 8 || r.Entries[1].Value != 2 || r.Entries[1].Rank != 2 {
+		t.Fatalf("alice should lead the score: %v", r)
+	}
+	r, err = g.Leaderboard(&api.LeaderboardRequest{Measure: api.LeaderboardRequest_ASSISTS, Scope: api.LeaderboardRequest_ALL_TIME})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Entries[0].Name != "bob" || r.Entries[0].Value != 1 || r.Entries[1].Value != 0 {
+		t.Fatalf("bob should get the assist: %v", r)
+	}
+	r, err = g.Leaderboard(&api.LeaderboardRequest{Measure: api.LeaderboardRequest_KILLS, Scope: api.LeaderboardRequest_ALL_TIME})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range r.Entries {
+		if e.Value != 1 || g.profiles[e.Name].Kills != 1 {
+			t.Fatalf("leaderboard and profile of %s should agree on the kills: %v %v", e.Name, r, g.profiles[e.Name])
+		}
+	}
+	if g.profiles["alice"].DamageDealt != 5 || g.profiles["bob"].TicksAlive != 2 {
+		t.Fatalf("profiles should be counted with the stats: %v", g.profiles)
+	}
+	r, err = g.Leaderboard(&api.LeaderboardRequest{Measure: api.LeaderboardRequest_KILLS, Scope: api.LeaderboardRequest_ALL_TIME, SinceTick: pointy.Int32(statsPeriod)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Entries) != 2 || r.Entries[0].Value != 0 || r.Entries[1].Rank != 1 || r.GetSinceTick() != statsPeriod {
+		t.Fatalf("kills should be outside of the window: %v", r)
+	}
+	r, err = g.Leaderboard(&api.LeaderboardRequest{Measure: api.LeaderboardRequest_SURVIVAL, Scope: api.LeaderboardRequest_RUN, Limit: pointy.Int32(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Entries) != 1 || r.Entries[0].Name != "bob" || r.Entries[0].Value != 2 {
+		t.Fatalf("run of alice should restart at the death: %v", r)
+	}
+	if _, err := g.Leaderboard(&api.LeaderboardRequest{Scope: api.LeaderboardRequest_RUN, SinceTick: pointy.Int32(0)}); err == nil {
+		t.Fatal("runs should not be filtered by time")
+	}
+
+	// the stats of the last processed tick are stored
+	g.Game.Tick++
+	g.storeGameState()
+	g.stores.close()
+	restored := NewGame(g.Config)
+	defer restored.stores.close()
+	if err := restored.loadGameState(); err != nil {
+		t.Fatal(err)
+	}
+	if restored.stats[statsPeriod]["bob"].GetTicksAlive() != 1 || restored.runs["bob"].GetTicksAlive() != 2 {
+		t.Fatalf("stats should be restored: %v %v", restored.stats, restored.runs)
+	}
+}
+
+func TestCompactStats(t *testing.T) {
+	g := newTestGame(t)
+	for _, period := range []int32{0, statsPeriod, 59 * statsPeriod, 60 * statsPeriod, 120 * statsPeriod} {
+		g.stats[period] = map[string]*api.PlayerStats{"alice": {Name: "alice", SinceTick: period, Kills: 1}}
+		g.Game.Tick = period + 1
+		g.storeStats()
+	}
+	g.Game.Tick = 121 * statsPeriod
+	g.compactStats(g.Game.Tick)
+	if len(g.stats) != 3 || g.stats[0]["alice"].GetKills() != 3 || !g.changedStats[statsPeriod] {
+		t.Fatalf("periods older than an hour should be compacted: %v", g.stats)
+	}
+
+	g.Game.Tick++
+	r, err := g.Leaderboard(&api.LeaderboardRequest{Measure: api.LeaderboardRequest_KILLS, Scope: api.LeaderboardRequest_ALL_TIME,
+		SinceTick: pointy.Int32(100), UntilTick: pointy.Int32(100)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetSinceTick() != 0 || r.GetUntilTick() != 60*statsPeriod-1 || r.Entries[0].Value != 3 {
+		t.Fatalf("time window should be rounded to the compacted period: %v", r)
+	}
+
+	g.storeGameState()
+	g.stores.close()
+	restored := NewGame(g.Config)
+	defer restored.stores.close()
+	if err := restored.loadGameState(); err != nil {
+		t.Fatal(err)
+	}
+	if len(restored.stats) != 3 || restored.stats[0]["alice"].GetKills() != 3 {
+		t.Fatalf("compacted periods should be restored: %v", restored.stats)
+	}
+}
@@ -0,0 +1,257 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// AsyncWriter writes the buckets of the backend in the background. All the values queued since the last write are
+// written together (in a single transaction when the backend supports it), only the last value of a key is written
+// when the writes pile up and unchanged values are not written again.
+type AsyncWriter struct {
+	backend Backend
+
+	// held by the batches and by the writer taking the queued values
+	batch sync.Mutex
+	lock  sync.Mutex
+	// values waiting for the writer by the buckets, nil for the deleted keys
+	pending map[string]map[string]*value
+	// values being written by the writer
+	writing map[string]map[string]*value
+	closed  bool
+
+	// values in the backend (used only by the writer)
+	stored map[string]map[string]string
+	wake   chan struct{}
+	done   chan struct{}
+}
+
+// value is serialized when it is queued, the proto messages (which must not be changed after they are queued) are
+// serialized by the writer.
+type value struct {
+	j json.RawMessage
+	m proto.Message
+}
+
+func (v *value) marshal() (json.RawMessage, error) {
+	if v.m != nil {
+		return protojson.Marshal(v.m)
+	}
+	return v.j, nil
+}
+
+func NewAsyncWriter(b Backend) *AsyncWriter {
+	w := &AsyncWriter{
+		backend: b,
+		pending: map[string]map[string]*value{},
+		stored:  map[string]map[string]string{},
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Bucket returns the bucket of the backend written by the writer.
+func (w *AsyncWriter) Bucket(name string) (*AsyncStore, error) {
+	s, err := w.backend.Bucket(name)
+	if err != nil {
+		return nil, err
+	}
+	return &AsyncStore{w: w, name: name, store: s}, nil
+}
+
+// Batch runs the function, the values it queues are written together.
+func (w *AsyncWriter) Batch(f func()) {
+	w.batch.Lock()
+	defer w.batch.Unlock()
+	f()
+}
+
+func (w *AsyncWriter) queue(bucket string, values map[string]*value) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed {
+		return errors.New("storage is closed")
+	}
+	if _, ok := w.pending[bucket]; !ok {
+		w.pending[bucket] = map[string]*value{}
+	}
+	for key, v := range values {
+		w.pending[bucket][key] = v
+	}
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// queued returns the value which was not written yet.
+func (w *AsyncWriter) queued(bucket string, key string) (*value, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if v, ok := w.pending[bucket][key]; ok {
+		return v, true
+	}
+	v, ok := w.writing[bucket][key]
+	return v, ok
+}
+
+func (w *AsyncWriter) run() {
+	for range w.wake {
+		w.flush()
+	}
+	w.flush()
+	close(w.done)
+}
+
+func (w *AsyncWriter) flush() {
+	w.batch.Lock()
+	w.lock.Lock()
+	w.writing = w.pending
+	w.pending = map[string]map[string]*value{}
+	w.lock.Unlock()
+	w.batch.Unlock()
+
+	changes := Changes{}
+	for bucket, values := range w.writing {
+		if _, ok := w.stored[bucket]; !ok {
+			w.stored[bucket] = map[string]string{}
+		}
+		for key, v := range values {
+			if v == nil {
+				if _, ok := changes[bucket]; !ok {
+					changes[bucket] = map[string]json.RawMessage{}
+				}
+				changes[bucket][key] = nil
+				continue
+			}
+			j, err := v.marshal()
+			if err != nil {
+				log.Warn().Err(err).Msgf("%s was not serialized", key)
+				continue
+			}
+			if w.stored[bucket][key] != string(j) {
+				if _, ok := changes[bucket]; !ok {
+					changes[bucket] = map[string]json.RawMessage{}
+				}
+				changes[bucket][key] = j
+			}
+		}
+	}
+	if len(changes) > 0 {
+		if err := w.backend.Update(changes); err != nil {
+			log.Warn().Err(err).Msg("Values were not written to the storage")
+		} else {
+			for bucket, values := range changes {
+				for key, j := range values {
+					if j == nil {
+						delete(w.stored[bucket], key)
+					} else {
+						w.stored[bucket][key] = string(j)
+					}
+				}
+			}
+		}
+	}
+
+	w.lock.Lock()
+	w.writing = nil
+	w.lock.Unlock()
+}
+
+// Close writes the queued values and stops the writer.
+func (w *AsyncWriter) Close() {
+	w.lock.Lock()
+	if w.closed {
+		w.lock.Unlock()
+		return
+	}
+	w.closed = true
+	close(w.wake)
+	w.lock.Unlock()
+	<-w.done
+}
+
+// AsyncStore is a bucket written by the AsyncWriter. The values are serialized when they are written (so they can be
+// changed right after).
+type AsyncStore struct {
+	w     *AsyncWriter
+	name  string
+	store Store
+}
+
+func (a *AsyncStore) Write(key string, value any) error {
+	return a.WriteAll(map[string]any{key: value})
+}
+
+func (a *AsyncStore) WriteAll(values map[string]any) error {
+	data := map[string]*value{}
+	for key, v := range values {
+		j, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		data[key] = &value{j: j}
+	}
+	return a.w.queue(a.name, data)
+}
+
+// WriteProto queues the message which is serialized (to the proto JSON) by the writer, the message must not be
+// changed after it is queued.
+func (a *AsyncStore) WriteProto(key string, m proto.Message) error {
+	return a.w.queue(a.name, map[string]*value{key: {m: m}})
+}
+
+func (a *AsyncStore) Delete(key string) error {
+	return a.w.queue(a.name, map[string]*value{key: nil})
+}
+
+func (a *AsyncStore) ReadTo(key string, v any) error {
+	queued, ok := a.w.queued(a.name, key)
+	if !ok {
+		return a.store.ReadTo(key, v)
+	}
+	if queued == nil {
+		return fmt.Errorf("key %s not found", key)
+	}
+	j, err := queued.marshal()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(j, v)
+}
+
+func (a *AsyncStore) Keys() ([]string, error) {
+	keys, err := a.store.Keys()
+	if err != nil {
+		return nil, err
+	}
+	a.w.lock.Lock()
+	defer a.w.lock.Unlock()
+	present := map[string]bool{}
+	for _, k := range keys {
+		present[k] = true
+	}
+	for _, queued := range []map[string]map[string]*value{a.w.writing, a.w.pending} {
+		for k, v := range queued[a.name] {
+			present[k] = v != nil
+		}
+	}
+	keys = keys[:0]
+	for k, ok := range present {
+		if ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
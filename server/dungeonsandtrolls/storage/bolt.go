@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltDB keeps the buckets in a bbolt database, only the changed keys are written.
+type BoltDB struct {
+	db *bolt.DB
+}
+
+func OpenBolt(path string) (*BoltDB, error) {
+	// the database is locked by a single process
+	db, err := bolt.Open(path, 0644, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("bolt storage %s: %w", path, err)
+	}
+	return &BoltDB{db: db}, nil
+}
+
+func (b *BoltDB) Bucket(name string) (Store, error) {
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(name))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bucket %s: %w", name, err)
+	}
+	return &BoltStore{db: b.db, bucket: []byte(name)}, nil
+}
+
+// Update changes the buckets in a single transaction.
+func (b *BoltDB) Update(c Changes) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		for name, values := range c {
+			bucket, err := tx.CreateBucketIfNotExists([]byte(name))
+			if err != nil {
+				return fmt.Errorf("bucket %s: %w", name, err)
+			}
+			for key, j := range values {
+				if j == nil {
+					err = bucket.Delete([]byte(key))
+				} else {
+					err = bucket.Put([]byte(key), j)
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (b *BoltDB) Close() error {
+	return b.db.Close()
+}
+
+// BoltStore is a single bucket of the bbolt database.
+type BoltStore struct {
+	db     *bolt.DB
+	bucket []byte
+}
+
+func (s *BoltStore) Write(key string, value any) error {
+	return s.WriteAll(map[string]any{key: value})
+}
+
+func (s *BoltStore) WriteAll(values map[string]any) error {
+	data := map[string][]byte{}
+	for key, value := range values {
+		j, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		data[key] = j
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.bucket)
+		for key, j := range data {
+			if err := b.Put([]byte(key), j); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) ReadTo(key string, v any) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		j := tx.Bucket(s.bucket).Get([]byte(key))
+		if j == nil {
+			return fmt.Errorf("key %s not found in the bucket %s", key, s.bucket)
+		}
+		// the value is valid only in the transaction
+		return json.Unmarshal(j, v)
+	})
+}
+
+func (s *BoltStore) Delete(key string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(s.bucket).Delete([]byte(key))
+	})
+}
+
+func (s *BoltStore) Keys() ([]string, error) {
+	var keys []string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		// bbolt keeps the keys sorted
+		return tx.Bucket(s.bucket).ForEach(func(k, _ []byte) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+	})
+	return keys, err
+}
@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// FileStore keeps the whole bucket in a JSON file which is rewritten on every change.
+type FileStore struct {
+	path string
+	lock sync.RWMutex
+	data map[string]string
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	_, err := os.Stat(path)
+	var j []byte
+	if os.IsNotExist(err) {
+		j = []byte("{}")
+	} else {
+		j, err = os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s := &FileStore{
+		path: path,
+	}
+	err = json.Unmarshal(j, &s.data)
+	return s, err
+}
+
+func (s *FileStore) write() error {
+	j, err := json.Marshal(s.data)
+	if err != nil {
+		return err
+	}
+	tempFile := s.path + ".tmp"
+	err = os.WriteFile(tempFile, j, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tempFile, s.path)
+}
+
+// Write a value (has to be serializable to JSON) to the storage identified by the key.
+func (s *FileStore) Write(key string, value any) error {
+	j, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.data[key] = string(j)
+	return s.write()
+}
+
+func (s *FileStore) WriteAll(values map[string]any) error {
+	data := map[string]string{}
+	for key, value := range values {
+		j, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		data[key] = string(j)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for key, j := range data {
+		s.data[key] = j
+	}
+	return s.write()
+}
+
+func (s *FileStore) Read(key string) (any, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	jd, ok := s.data[key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in %s", key, s.path)
+	}
+	var v interface{}
+	err := json.Unmarshal([]byte(jd), &v)
+	return v, err
+}
+
+func (s *FileStore) ReadTo(key string, v any) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	jd, ok := s.data[key]
+	if !ok {
+		return fmt.Errorf("key %s not found in %s", key, s.path)
+	}
+	return json.Unmarshal([]byte(jd), &v)
+}
+
+// update writes and deletes (nil values) the keys with a single rewrite of the file.
+func (s *FileStore) update(values map[string]json.RawMessage) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for key, j := range values {
+		if j == nil {
+			delete(s.data, key)
+		} else {
+			s.data[key] = string(j)
+		}
+	}
+	return s.write()
+}
+
+// Delete removes the key from the storage.
+func (s *FileStore) Delete(key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.data, key)
+	return s.write()
+}
+
+func (s *FileStore) Keys() ([]string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	keys := make([]string, 0, len(s.data))
+	for key := range s.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// FileBackend keeps every bucket in a separate JSON file in the directory.
+type FileBackend struct {
+	dir string
+
+	lock    sync.Mutex
+	buckets map[string]*FileStore
+}
+
+func (b *FileBackend) Bucket(name string) (Store, error) {
+	return b.bucket(name)
+}
+
+// bucket returns the opened bucket, so the updates and the bucket share the data.
+func (b *FileBackend) bucket(name string) (*FileStore, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if s, ok := b.buckets[name]; ok {
+		return s, nil
+	}
+	s, err := NewFileStore(filepath.Join(b.dir, name+".json"))
+	if err != nil {
+		return nil, err
+	}
+	if b.buckets == nil {
+		b.buckets = map[string]*FileStore{}
+	}
+	b.buckets[name] = s
+	return s, nil
+}
+
+// Update rewrites the files of the changed buckets one by one (the files are not changed together).
+func (b *FileBackend) Update(c Changes) error {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s, err := b.bucket(name)
+		if err != nil {
+			return err
+		}
+		if err := s.update(c[name]); err != nil {
+			return fmt.Errorf("bucket %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (b *FileBackend) Close() error {
+	return nil
+}
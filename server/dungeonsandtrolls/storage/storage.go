@@ -1,4 +1,4 @@
-// Persistent storage backed by a file.
+// Persistent storage of JSON values by keys split into buckets.
 
 package storage
 
@@ -6,76 +6,50 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"sync"
+	"path/filepath"
 )
 
-type Storage struct {
-	path string
-	lock sync.RWMutex
-	data map[string]string
-}
+const (
+	// JsonBackend keeps every bucket in a JSON file.
+	JsonBackend = "json"
+	// BoltBackend keeps all the buckets in a single bbolt database.
+	BoltBackend = "bolt"
+)
 
-func NewStorage(path string) (*Storage, error) {
-	_, err := os.Stat(path)
-	var j []byte
-	if os.IsNotExist(err) {
-		j = []byte("{}")
-	} else {
-		j, err = os.ReadFile(path)
-		if err != nil {
-			return nil, err
-		}
-	}
-	s := &Storage{
-		path: path,
-	}
-	err = json.Unmarshal(j, &s.data)
-	return s, err
+const boltFile = "game.db"
+
+// Store keeps the values (serializable to JSON) of a single bucket.
+type Store interface {
+	Write(key string, value any) error
+	// WriteAll writes the values in a single transaction.
+	WriteAll(values map[string]any) error
+	ReadTo(key string, v any) error
+	Delete(key string) error
+	// Keys returns the sorted keys of the bucket.
+	Keys() ([]string, error)
 }
 
-func (s *Storage) write() error {
-	j, err := json.Marshal(s.data)
-	if err != nil {
-		return err
-	}
-	tempFile := s.path + ".tmp"
-	err = os.WriteFile(tempFile, j, 0644)
-	if err != nil {
-		return err
-	}
-	return os.Rename(tempFile, s.path)
-}
+// Changes of the serialized values by the buckets and the keys, nil deletes the key.
+type Changes map[string]map[string]json.RawMessage
 
-// Write a value (has to be serializable to JSON) to the storage identified by the key.
-func (s *Storage) Write(key string, value any) error {
-	j, err := json.Marshal(value)
-	if err != nil {
-		return err
-	}
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	s.data[key] = string(j)
-	return s.write()
+// Backend opens the buckets of a single storage.
+type Backend interface {
+	Bucket(name string) (Store, error)
+	// Update changes several buckets at once.
+	Update(c Changes) error
+	Close() error
 }
 
-func (s *Storage) Read(key string) (any, error) {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
-	jd, ok := s.data[key]
-	if !ok {
-		return nil, fmt.Errorf("key %s not found in %s", key, s.path)
-	}
-	var v interface{}
-	err := json.Unmarshal([]byte(jd), &v)
-	return v, err
-}
-
-func (s *Storage) ReadTo(key string, v any) error {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
-	jd, ok := s.data[key]
-	if !ok {
-		return fmt.Errorf("key %s not found in %s", key, s.path)
+// Open opens the storage backend in the directory.
+func Open(backend string, dir string) (Backend, error) {
+	switch backend {
+	case JsonBackend:
+		return &FileBackend{dir: dir}, nil
+	case BoltBackend:
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+		return OpenBolt(filepath.Join(dir, boltFile))
 	}
-	return json.Unmarshal([]byte(jd), &v)
+	return nil, fmt.Errorf("unknown storage backend %s", backend)
 }
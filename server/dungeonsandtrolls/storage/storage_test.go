@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestBackends(t *testing.T) {
+	for _, backend := range []string{JsonBackend, BoltBackend} {
+		t.Run(backend, func(t *testing.T) {
+			dir := t.TempDir()
+			b, err := Open(backend, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w := NewAsyncWriter(b)
+			a, err := w.Bucket("players")
+			if err != nil {
+				t.Fatal(err)
+			}
+			scores, err := w.Bucket("scores")
+			if err != nil {
+				t.Fatal(err)
+			}
+			w.Batch(func() {
+				if err := a.WriteAll(map[string]any{"b": 2, "a": 1, "c": 3}); err != nil {
+					t.Fatal(err)
+				}
+				if err := a.Delete("c"); err != nil {
+					t.Fatal(err)
+				}
+				if err := scores.WriteProto("tick", wrapperspb.Int32(5)); err != nil {
+					t.Fatal(err)
+				}
+			})
+			var v int
+			if err := a.ReadTo("a", &v); err != nil || v != 1 {
+				t.Fatalf("queued value should be read, %d: %v", v, err)
+			}
+			if err := scores.ReadTo("tick", &v); err != nil || v != 5 {
+				t.Fatalf("queued message should be read, %d: %v", v, err)
+			}
+			w.Close()
+			if err := a.Write("a", 4); err == nil {
+				t.Fatal("closed store should not accept writes")
+			}
+			if err := b.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			b, err = Open(backend, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer b.Close()
+			s, err := b.Bucket("players")
+			if err != nil {
+				t.Fatal(err)
+			}
+			keys, err := s.Keys()
+			if err != nil || len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+				t.Fatalf("written keys should be stored, %v: %v", keys, err)
+			}
+			if err := s.ReadTo("b", &v); err != nil || v != 2 {
+				t.Fatalf("written value should be stored, %d: %v", v, err)
+			}
+			if err := s.ReadTo("c", &v); err == nil {
+				t.Fatal("deleted key should not be found")
+			}
+			s, err = b.Bucket("scores")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := s.ReadTo("tick", &v); err != nil || v != 5 {
+				t.Fatalf("message should be stored, %d: %v", v, err)
+			}
+			other, err := b.Bucket("keys")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if keys, _ := other.Keys(); len(keys) != 0 {
+				t.Fatalf("buckets should be separate: %v", keys)
+			}
+		})
+	}
+}
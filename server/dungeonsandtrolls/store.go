@@ -0,0 +1,157 @@
+package dungeonsandtrolls
+
+import (
+	"fmt"
+
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// Buckets of the storage.
+const (
+	// players by their names
+	playersBucket = "players"
+	// API keys (see keys.go)
+	keysBucket = "keys"
+	// scores and the progress of the game
+	scoresBucket = "scores"
+	// world snapshots (see snapshot.go)
+	snapshotsBucket = "snapshots"
+	// lifetime stats of the players by their names (see profile.go)
+	profilesBucket = "profiles"
+	// all time stats of the players by the stats periods (see stats.go)
+	statsBucket = "stats"
+)
+
+// Keys of the scores bucket.
+const (
+	tickKey            = "tick"
+	scoreKey           = "score"
+	maxLevelReachedKey = "max_reached_level"
+	bannedUsersKey     = "banned_users"
+	// stats of the current lives of the players
+	runsKey = "runs"
+)
+
+// stores of the game buckets, the ones written every tick are written together in the background
+type stores struct {
+	backend   storage.Backend
+	writer    *storage.AsyncWriter
+	players   *storage.AsyncStore
+	keys      storage.Store
+	scores    *storage.AsyncStore
+	snapshots *storage.AsyncStore
+	profiles  *storage.AsyncStore
+	stats     *storage.AsyncStore
+}
+
+func openStores(c config.Storage) (*stores, error) {
+	backend, err := storage.Open(c.Backend, c.Path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := backend.Bucket(keysBucket)
+	if err != nil {
+		backend.Close()
+		return nil, err
+	}
+	s := &stores{
+		backend: backend,
+		writer:  storage.NewAsyncWriter(backend),
+		keys:    keys,
+	}
+	for name, store := range map[string]**storage.AsyncStore{
+		playersBucket:   &s.players,
+		scoresBucket:    &s.scores,
+		snapshotsBucket: &s.snapshots,
+		profilesBucket:  &s.profiles,
+		statsBucket:     &s.stats,
+	} {
+		*store, err = s.writer.Bucket(name)
+		if err != nil {
+			s.close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// close writes the queued values and closes the backend.
+func (s *stores) close() {
+	s.writer.Close()
+	if err := s.backend.Close(); err != nil {
+		log.Warn().Err(err).Msg("Storage was not closed")
+	}
+}
+
+// loadGameState reads the scores and the players, an error is returned when the game was not stored yet.
+func (g *Game) loadGameState() error {
+	if err := g.stores.scores.ReadTo(scoreKey, &g.Score); err != nil {
+		return err
+	}
+	if err := g.stores.scores.ReadTo(maxLevelReachedKey, &g.MaxLevelReached); err != nil {
+		return err
+	}
+	if err := g.stores.scores.ReadTo(bannedUsersKey, &g.BannedUsers); err != nil {
+		return err
+	}
+	names, err := g.stores.players.Keys()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		p := &gameobject.Player{}
+		if err := g.stores.players.ReadTo(name, p); err != nil {
+			return fmt.Errorf("player %s: %w", name, err)
+		}
+		g.Players[name] = p
+		g.storedPlayers[name] = true
+	}
+	if err := g.loadProfiles(); err != nil {
+		return err
+	}
+	return g.loadStats()
+}
+
+// storeGameState queues the state of the game to the storage. The writes happen in the background, the whole state
+// is written together.
+func (g *Game) storeGameState() {
+	g.GameLock.RLock()
+	defer g.GameLock.RUnlock()
+	g.stores.writer.Batch(g.queueGameState)
+}
+
+func (g *Game) queueGameState() {
+
+	players := map[string]any{}
+	for name, p := range g.Players {
+		players[name] = p
+	}
+	if err := g.stores.players.WriteAll(players); err != nil {
+		log.Warn().Err(err).Msg("Players were not stored")
+	}
+	for name := range g.storedPlayers {
+		if _, ok := g.Players[name]; !ok {
+			g.stores.players.Delete(name)
+			delete(g.storedPlayers, name)
+		}
+	}
+	for name := range g.Players {
+		g.storedPlayers[name] = true
+	}
+
+	err := g.stores.scores.WriteAll(map[string]any{
+		tickKey:            g.Game.Tick,
+		scoreKey:           g.Score,
+		maxLevelReachedKey: g.MaxLevelReached,
+		bannedUsersKey:     g.BannedUsers,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("Scores were not stored")
+	}
+	g.storeProfiles()
+	g.storeStats()
+	g.storeWorld()
+}
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"fmt"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -8,10 +9,13 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-const binary = "./generator/dntgenerator"
-
-func GenerateLevel(start int32, end int32, max int32) string {
-	cmd := exec.Command(binary, "-s", strconv.Itoa(int(start)), "-e", strconv.Itoa(int(end)), "-m", strconv.Itoa(int(max)), "-j", "-", "-h", "")
+// GenerateLevel runs the generator, the seed is passed by the seedFlag when it is not zero.
+func GenerateLevel(binary string, start int32, end int32, max int32, seedFlag string, seed int64) string {
+	args := []string{"-s", strconv.Itoa(int(start)), "-e", strconv.Itoa(int(end)), "-m", strconv.Itoa(int(max)), "-j", "-", "-h", ""}
+	if seed != 0 && seedFlag != "" {
+		args = append(args, seedFlag, strconv.FormatInt(seed, 10))
+	}
+	cmd := exec.Command(binary, args...)
 
 	stderr := &strings.Builder{}
 	stdout := &strings.Builder{}
@@ -27,3 +31,12 @@ func GenerateLevel(start int32, end int32, max int32) string {
 
 	return stdout.String()
 }
+
+// Check verifies that the generator binary exists and can be executed.
+func Check(binary string) error {
+	_, err := exec.LookPath(binary)
+	if err != nil {
+		return fmt.Errorf("generator is not available: %w", err)
+	}
+	return nil
+}
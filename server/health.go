@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"time"
+)
+
+// health reports the readiness of the game to the gRPC health checking and to the HTTP probes.
+type health struct {
+	g      *dungeonsandtrolls.Game
+	server *grpchealth.Server
+}
+
+func newHealth(g *dungeonsandtrolls.Game) *health {
+	h := &health{
+		g:      g,
+		server: grpchealth.NewServer(),
+	}
+	h.update()
+	return h
+}
+
+func (h *health) update() {
+	status := healthpb.HealthCheckResponse_SERVING
+	if h.g.Ready() != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	h.server.SetServingStatus("", status)
+	h.server.SetServingStatus(api.DungeonsAndTrolls_ServiceDesc.ServiceName, status)
+}
+
+// watch updates the gRPC serving status periodically until the game stops.
+func (h *health) watch() {
+	t := time.NewTicker(h.g.Config.Game.CheckInterval())
+	defer t.Stop()
+	for {
+		select {
+		case <-h.g.Done():
+			return
+		case <-t.C:
+			h.update()
+		}
+	}
+}
+
+// shutdown reports not serving from now on.
+func (h *health) shutdown() {
+	h.server.Shutdown()
+}
+
+// healthz reports that the server is alive.
+func (h *health) healthz(w http.ResponseWriter, _ *http.Request) {
+	fmt.Fprintln(w, "ok")
+}
+
+// readyz reports whether the server is ready to serve the players.
+func (h *health) readyz(w http.ResponseWriter, _ *http.Request) {
+	if err := h.g.Ready(); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
@@ -5,38 +5,45 @@ import (
 	"fmt"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/api"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/apierror"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/auth"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/config"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/gameobject"
 	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/handlers"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/keydelivery"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/metrics"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/ratelimit"
+	"github.com/gdg-garage/dungeons-and-trolls/server/dungeonsandtrolls/tracing"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/zerolog/log"
+	"go.openly.dev/pointy"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"sort"
 	"strings"
+	"sync"
+	"syscall"
 )
 
-const apiKeyFieldName = "X-API-key"
-
-func getToken(ctx context.Context) (string, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return "", fmt.Errorf("cannot read request metadata (api key is missing)")
-	}
-	tokens := md.Get(apiKeyFieldName)
-	if len(tokens) != 1 {
-		return "", fmt.Errorf("incorrect number of auth tokens: %d", len(tokens))
-	}
-	return tokens[0], nil
-}
+// streams are closed with this error when the server is shutting down
+var errShuttingDown = apierror.New(api.ErrorCode_NOT_AVAILABLE, "the server is shutting down")
 
 type server struct {
 	api.UnsafeDungeonsAndTrollsServer
-	G *dungeonsandtrolls.Game
+	G           *dungeonsandtrolls.Game
+	KeyDelivery keydelivery.KeyDelivery
 }
 
 func filterGameState(game *dungeonsandtrolls.Game, g *api.GameState, level *int32, position *api.Position) {
@@ -61,17 +68,7 @@ func filterGameState(game *dungeonsandtrolls.Game, g *api.GameState, level *int3
 		}
 
 		if position != nil {
-			distInfo := gameobject.CalculateDistanceAndLineOfSight(l, position)
-			for p, i := range distInfo {
-				l.PlayerMap = append(l.PlayerMap, &api.PlayerSpecificMap{
-					Position: &api.Position{
-						PositionX: p.PositionX,
-						PositionY: p.PositionY,
-					},
-					LineOfSight: i.LineOfSight,
-					Distance:    int32(i.Distance),
-				})
-			}
+			addPlayerMap(l, position)
 		}
 
 		keptLevels = append(keptLevels, l)
@@ -85,6 +82,36 @@ func filterGameState(game *dungeonsandtrolls.Game, g *api.GameState, level *int3
 	}
 }
 
+func addPlayerMap(l *api.Level, position *api.Position) {
+	distInfo := gameobject.CalculateDistanceAndLineOfSight(l, position)
+	for p, i := range distInfo {
+		l.PlayerMap = append(l.PlayerMap, &api.PlayerSpecificMap{
+			Position: &api.Position{
+				PositionX: p.PositionX,
+				PositionY: p.PositionY,
+			},
+			LineOfSight: i.LineOfSight,
+			Distance:    int32(i.Distance),
+		})
+	}
+}
+
+// filterSpectatorGameState only selects the levels, spectators see everything else unfiltered. The player map is
+// added for the level of the Character (if the spectator has one).
+func filterSpectatorGameState(g *api.GameState, level *int32, position *api.Coordinates) {
+	var keptLevels []*api.Level
+	for _, l := range g.Map.Levels {
+		if level != nil && l.Level != *level {
+			continue
+		}
+		if position != nil && l.Level == position.Level {
+			addPlayerMap(l, gameobject.CoordinatesToPosition(position))
+		}
+		keptLevels = append(keptLevels, l)
+	}
+	g.Map.Levels = keptLevels
+}
+
 func filterMonsterGameState(game *dungeonsandtrolls.Game, g *api.GameState) {
 	g.ShopItems = []*api.Item{}
 }
@@ -109,23 +136,35 @@ func isBlocking(blocking *bool) bool {
 }
 
 func (s *server) gameState(ctx context.Context, params *api.GameStateParams, level *int32) (*api.GameState, error) {
-	token, err := getToken(ctx)
-
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	s.G.GameLock.RUnlock()
 
 	// GameContext is special and is not blocking by default also it is blocking before the actual work
 	if params.Blocking != nil && *params.Blocking {
-		s.G.WaitForNextTick(tick)
+		if err := s.G.WaitForNextTick(ctx, tick); err != nil {
+			return nil, err
+		}
 	}
 
+	return s.currentGameState(ctx, params, level)
+}
+
+// currentGameState returns the GameState of the current tick filtered for the caller identified by the context.
+func (s *server) currentGameState(ctx context.Context, params *api.GameStateParams, level *int32) (*api.GameState, error) {
+	token, err := auth.ApiKey(ctx)
+
 	s.G.GameLock.RLock()
 	g, ok := proto.Clone(&s.G.Game).(*api.GameState)
 	g.MaxLevel = s.G.MaxLevelReached
 	if !ok {
 		return nil, fmt.Errorf("cloning GameState failed")
 	}
+	var changes *dungeonsandtrolls.Changes
+	if params.SinceTick != nil {
+		// full state is sent when the changes are not known
+		changes, _ = s.G.ChangesSince(*params.SinceTick)
+	}
 	s.G.GameLock.RUnlock()
 
 	if params.Items != nil && !*params.Items {
@@ -155,27 +194,52 @@ func (s *server) gameState(ctx context.Context, params *api.GameStateParams, lev
 	// token not found
 	if err != nil || len(token) == 0 {
 		filterGameState(s.G, g, level, nil)
+		if changes != nil {
+			dungeonsandtrolls.ApplyDelta(g, changes)
+		}
 		return g, nil
 	}
 	// token is present
-	p, err := s.G.GetPlayerByKey(token)
+	roles, err := s.G.Roles(token)
 	if err != nil {
 		return nil, err
 	}
-	if !p.IsAdmin {
-		if strings.HasPrefix(p.GetName(), "leonidas") {
-			filterGameState(s.G, g, level, gameobject.CoordinatesToPosition(p.GetPosition()))
-		} else if level != nil {
-			filterGameState(s.G, g, level, gameobject.CoordinatesToPosition(p.GetPosition()))
-		} else if level == nil {
-			filterGameState(s.G, g, &p.GetPosition().Level, gameobject.CoordinatesToPosition(p.GetPosition()))
+	var p *gameobject.Player
+	if auth.HasRole(roles, auth.RolePlayer) || auth.HasRole(roles, auth.RoleViewer) {
+		p, err = s.G.GetPlayerByKey(token)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case auth.HasRole(roles, auth.RoleSpectator):
+		var position *api.Coordinates
+		if p != nil {
+			position = p.GetPosition()
 		}
+		filterSpectatorGameState(g, level, position)
+	case auth.HasRole(roles, auth.RoleMonsterPuppeteer):
+		filterMonsterGameState(s.G, g)
+	case p != nil && level != nil:
+		filterGameState(s.G, g, level, gameobject.CoordinatesToPosition(p.GetPosition()))
+	case p != nil:
+		filterGameState(s.G, g, &p.GetPosition().Level, gameobject.CoordinatesToPosition(p.GetPosition()))
+	default:
+		filterGameState(s.G, g, level, nil)
+	}
+	if p != nil {
 		g.Character = p.Character
+		s.G.GameLock.RLock()
+		g.CommandResult = s.G.CommandResult(g.Tick-1, p.GetId())
+		s.G.GameLock.RUnlock()
 		g.CurrentPosition = gameobject.CoordinatesToPosition(p.GetPosition())
 		g.CurrentLevel = &p.GetPosition().Level
-	} else {
-		// Monster admin
-		filterMonsterGameState(s.G, g)
+	}
+	if changes != nil {
+		if p != nil {
+			changes.AddEnteredLevels(p.GetId())
+		}
+		dungeonsandtrolls.ApplyDelta(g, changes)
 	}
 
 	return g, nil
@@ -185,6 +249,218 @@ func (s *server) Game(ctx context.Context, params *api.GameStateParams) (*api.Ga
 	return s.gameState(ctx, params, nil)
 }
 
+func (s *server) WatchGame(params *api.GameStateParams, stream api.DungeonsAndTrolls_WatchGameServer) error {
+	ctx := stream.Context()
+
+	s.G.GameLock.RLock()
+	tick := s.G.Game.Tick
+	s.G.GameLock.RUnlock()
+
+	for {
+		if err := s.G.WaitForNextTick(ctx, tick); err != nil {
+			return err
+		}
+		if s.G.Stopping() {
+			return errShuttingDown
+		}
+		g, err := s.currentGameState(ctx, params, nil)
+		if err != nil {
+			return err
+		}
+		// Continue from the tick which was actually sent (more ticks may have passed in the meantime).
+		tick = g.Tick
+		if params.SinceTick != nil {
+			// following updates are deltas from the last sent state
+			params.SinceTick = &tick
+		}
+		err = stream.Send(g)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// playStream holds the state of a single Play stream.
+type playStream struct {
+	stream api.DungeonsAndTrolls_PlayServer
+	// the stream does not support concurrent sends
+	sendLock sync.Mutex
+	// statuses of the accepted batches by the tick in which they were accepted
+	pending     map[int32][]*api.CommandsBatchStatus
+	pendingLock sync.Mutex
+}
+
+func (ps *playStream) send(u *api.PlayUpdate) error {
+	ps.sendLock.Lock()
+	defer ps.sendLock.Unlock()
+	return ps.stream.Send(u)
+}
+
+// receiveCommands validates and queues the commands batches received on the stream until the client stops sending.
+func (s *server) receiveCommands(ps *playStream, token string) error {
+	var batch int32
+	for {
+		commands, err := ps.stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		batch++
+
+		// every batch is traced separately (the stream may be open for the whole game)
+		ctx, span := tracing.Tracer().Start(ps.stream.Context(), "Play.Commands",
+			trace.WithAttributes(attribute.Int("batch", int(batch))))
+		s.G.GameLock.RLock()
+		tick := s.G.Game.Tick
+		result, err := handlers.Commands(ctx, s.G, commands, token)
+		s.G.GameLock.RUnlock()
+		tracing.End(span, err)
+
+		status := &api.CommandsBatchStatus{
+			Batch:  batch,
+			Status: api.CommandsBatchStatus_ACCEPTED,
+			Tick:   tick,
+			Result: result,
+		}
+		if err != nil {
+			status.Status = api.CommandsBatchStatus_REJECTED
+			status.Reason = pointy.String(err.Error())
+		}
+		err = ps.send(&api.PlayUpdate{Statuses: []*api.CommandsBatchStatus{status}})
+		if err != nil {
+			return err
+		}
+		if status.Status == api.CommandsBatchStatus_ACCEPTED {
+			// registered after the ACCEPTED status is sent so EXECUTED cannot overtake it
+			ps.pendingLock.Lock()
+			ps.pending[tick] = append(ps.pending[tick], status)
+			ps.pendingLock.Unlock()
+		}
+	}
+}
+
+// droppedCommands returns the commands accepted in the batch which are missing in the execution result (e.g. the
+// Character died or was removed before the tick was processed).
+func droppedCommands(accepted *api.CommandResult, executed *api.CommandResult) []string {
+	var dropped []string
+	for _, c := range []struct {
+		name     string
+		accepted *api.CommandStatus
+		executed *api.CommandStatus
+	}{
+		{"yell", accepted.GetYell(), executed.GetYell()},
+		{"skill", accepted.GetSkill(), executed.GetSkill()},
+		{"buy", accepted.GetBuy(), executed.GetBuy()},
+		{"pick up", accepted.GetPickUp(), executed.GetPickUp()},
+		{"assign skill points", accepted.GetAssignSkillPoints(), executed.GetAssignSkillPoints()},
+	} {
+		if c.accepted != nil && c.accepted.Status == api.CommandStatus_ACCEPTED && c.executed == nil {
+			dropped = append(dropped, c.name)
+		}
+	}
+	return dropped
+}
+
+// executedBatches returns statuses of the batches which were executed before the tick.
+func (s *server) executedBatches(ps *playStream, playerId string, tick int32) []*api.CommandsBatchStatus {
+	ps.pendingLock.Lock()
+	defer ps.pendingLock.Unlock()
+
+	var ticks []int32
+	for t := range ps.pending {
+		if t < tick {
+			ticks = append(ticks, t)
+		}
+	}
+	sort.Slice(ticks, func(i, j int) bool { return ticks[i] < ticks[j] })
+
+	var statuses []*api.CommandsBatchStatus
+	s.G.GameLock.RLock()
+	defer s.G.GameLock.RUnlock()
+	for _, t := range ticks {
+		batches := ps.pending[t]
+		delete(ps.pending, t)
+		for i, b := range batches {
+			status := &api.CommandsBatchStatus{
+				Batch:  b.Batch,
+				Status: api.CommandsBatchStatus_EXECUTED,
+				Tick:   t,
+			}
+			if i < len(batches)-1 {
+				// only the last batch sent during the tick is executed
+				status.Status = api.CommandsBatchStatus_REPLACED
+				status.Reason = pointy.String(fmt.Sprintf("replaced by batch %d", batches[len(batches)-1].Batch))
+			} else {
+				status.Result = s.G.CommandResult(t, playerId)
+				if dropped := droppedCommands(b.Result, status.Result); len(dropped) > 0 {
+					status.Status = api.CommandsBatchStatus_DROPPED
+					status.Reason = pointy.String(fmt.Sprintf("not executed: %s", strings.Join(dropped, ", ")))
+				}
+			}
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+func (s *server) Play(stream api.DungeonsAndTrolls_PlayServer) error {
+	ctx := stream.Context()
+	// the stream is authenticated only once when it is opened
+	token, err := auth.ApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	s.G.GameLock.RLock()
+	tick := s.G.Game.Tick
+	p, err := s.G.GetPlayerByKey(token)
+	s.G.GameLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	ps := &playStream{
+		stream:  stream,
+		pending: map[int32][]*api.CommandsBatchStatus{},
+	}
+	received := make(chan error, 1)
+	go func() {
+		received <- s.receiveCommands(ps, token)
+	}()
+
+	for {
+		// a cancelled stream is detected below (after the end of the receiving is handled)
+		s.G.WaitForNextTick(ctx, tick)
+		select {
+		case err := <-received:
+			// the client may stop sending commands and keep watching the game
+			if err != nil {
+				return err
+			}
+		default:
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if s.G.Stopping() {
+			return errShuttingDown
+		}
+		g, err := s.currentGameState(ctx, &api.GameStateParams{}, nil)
+		if err != nil {
+			return err
+		}
+		tick = g.Tick
+		err = ps.send(&api.PlayUpdate{
+			GameState: g,
+			Statuses:  s.executedBatches(ps, p.GetId(), tick),
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (s *server) Players(ctx context.Context, params *api.PlayersParams) (*api.PlayersInfo, error) {
 	var players []*api.Character
 
@@ -194,7 +470,9 @@ func (s *server) Players(ctx context.Context, params *api.PlayersParams) (*api.P
 	s.G.GameLock.RUnlock()
 	// Players are special and is not blocking by default also it is blocking before the actual work
 	if params.Blocking != nil && *params.Blocking {
-		s.G.WaitForNextTick(tick)
+		if err := s.G.WaitForNextTick(ctx, tick); err != nil {
+			return nil, err
+		}
 	}
 
 	// read players
@@ -216,7 +494,9 @@ func (s *server) Levels(ctx context.Context, params *api.PlayersParams) (*api.Av
 	s.G.GameLock.RUnlock()
 	// Levels are special and is not blocking by default also it is blocking before the actual work
 	if params.Blocking != nil && *params.Blocking {
-		s.G.WaitForNextTick(tick)
+		if err := s.G.WaitForNextTick(ctx, tick); err != nil {
+			return nil, err
+		}
 	}
 
 	// read levels
@@ -233,76 +513,129 @@ func (s *server) Levels(ctx context.Context, params *api.PlayersParams) (*api.Av
 
 func (s *server) GameLevel(ctx context.Context, params *api.GameStateParamsLevel) (*api.GameState, error) {
 	return s.gameState(ctx, &api.GameStateParams{
-		Blocking: params.Blocking,
-		Items:    params.Items,
-		FogOfWar: params.FogOfWar,
+		Blocking:  params.Blocking,
+		Items:     params.Items,
+		FogOfWar:  params.FogOfWar,
+		SinceTick: params.SinceTick,
 	}, &params.Level)
 }
 
 func (s *server) Register(ctx context.Context, user *api.User) (*api.Registration, error) {
-	r, err := handlers.RegisterUser(s.G, user)
+	r, err := handlers.RegisterUser(s.G, s.KeyDelivery, user)
 	if err != nil {
 		return nil, err
 	}
 	return r, nil
 }
 
-func (s *server) Buy(ctx context.Context, identifiers *api.IdentifiersWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+func (s *server) RotateApiKey(ctx context.Context, _ *emptypb.Empty) (*api.Registration, error) {
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
+	}
+	return handlers.RotateApiKey(s.G, s.KeyDelivery, token)
+}
+
+func (s *server) ListApiKeys(ctx context.Context, r *api.ApiKeysRequest) (*api.ApiKeys, error) {
+	token, err := auth.ApiKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.G.ApiKeys(token, r.GetOwner())
+}
+
+func (s *server) RevokeApiKey(ctx context.Context, id *api.Identifier) (*emptypb.Empty, error) {
+	token, err := auth.ApiKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, s.G.RevokeApiKey(token, id.Id)
+}
+
+func (s *server) IssueApiKey(ctx context.Context, r *api.ApiKeyRequest) (*api.Registration, error) {
+	token, err := auth.ApiKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handlers.IssueApiKey(s.G, r, token)
+}
+
+func (s *server) Profile(ctx context.Context, r *api.ProfileRequest) (*api.PlayerProfile, error) {
+	name := r.GetName()
+	if name == "" {
+		token, err := auth.ApiKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p, err := s.G.GetCurrentPlayer(token)
+		if err != nil {
+			return nil, err
+		}
+		name = p.GetName()
+	}
+	return s.G.Profile(name)
+}
+
+func (s *server) Leaderboard(ctx context.Context, r *api.LeaderboardRequest) (*api.Ranking, error) {
+	return s.G.Leaderboard(r)
+}
+
+func (s *server) Buy(ctx context.Context, identifiers *api.IdentifiersWithParams) (*api.CommandResult, error) {
+	token, err := auth.ApiKey(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.Buy(s.G, identifiers.Identifiers, token)
+	result, err := handlers.Buy(ctx, s.G, identifiers.Identifiers, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(identifiers.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
-	return &emptypb.Empty{}, err
+	return result, err
 }
 
-func (s *server) PickUp(ctx context.Context, identifier *api.IdentifierWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+func (s *server) PickUp(ctx context.Context, identifier *api.IdentifierWithParams) (*api.CommandResult, error) {
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
 	}
 
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.PickUp(s.G, identifier.Identifier, token)
+	result, err := handlers.PickUp(ctx, s.G, identifier.Identifier, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(identifier.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
-	return &emptypb.Empty{}, err
+	return result, err
 }
 
-func (s *server) Move(ctx context.Context, coordinates *api.PositionWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+func (s *server) Move(ctx context.Context, coordinates *api.PositionWithParams) (*api.CommandResult, error) {
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
 	}
 
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.Move(s.G, coordinates.Position, token)
+	result, err := handlers.Move(ctx, s.G, coordinates.Position, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(coordinates.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
-	return &emptypb.Empty{}, err
+	return result, err
 }
 
 func (s *server) Respawn(ctx context.Context, res *api.RespawnWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
 		return &emptypb.Empty{}, err
 	}
@@ -313,100 +646,99 @@ func (s *server) Respawn(ctx context.Context, res *api.RespawnWithParams) (*empt
 	err = handlers.Respawn(s.G, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(res.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
 	return &emptypb.Empty{}, err
 }
 
-func (s *server) Skill(ctx context.Context, skill *api.SkillUseWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+func (s *server) Skill(ctx context.Context, skill *api.SkillUseWithParams) (*api.CommandResult, error) {
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
 	}
 
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.Skill(s.G, skill.SkillUse, token)
+	result, err := handlers.Skill(ctx, s.G, skill.SkillUse, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(skill.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
-	return &emptypb.Empty{}, err
+	return result, err
 }
 
-func (s *server) Commands(ctx context.Context, commands *api.CommandsBatchWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+func (s *server) Commands(ctx context.Context, commands *api.CommandsBatchWithParams) (*api.CommandResult, error) {
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
 	}
 
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.Commands(s.G, commands.CommandsBatch, token)
+	result, err := handlers.Commands(ctx, s.G, commands.CommandsBatch, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(commands.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
-	return &emptypb.Empty{}, err
+	return result, err
 }
 
 func (s *server) MonstersCommands(ctx context.Context, commands *api.CommandsForMonstersWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
 	}
-
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.MonsterCommands(s.G, commands.CommandsForMonsters, token)
+	err = handlers.MonsterCommands(ctx, s.G, commands.CommandsForMonsters, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(commands.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
 	return &emptypb.Empty{}, err
 }
 
-func (s *server) Yell(ctx context.Context, message *api.MessageWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+func (s *server) Yell(ctx context.Context, message *api.MessageWithParams) (*api.CommandResult, error) {
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
 	}
 
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.Yell(s.G, message.Message, token)
+	result, err := handlers.Yell(ctx, s.G, message.Message, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(message.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
-	return &emptypb.Empty{}, err
+	return result, err
 }
 
-func (s *server) AssignSkillPoints(ctx context.Context, attributes *api.AttributesWithParams) (*emptypb.Empty, error) {
-	token, err := getToken(ctx)
+func (s *server) AssignSkillPoints(ctx context.Context, attributes *api.AttributesWithParams) (*api.CommandResult, error) {
+	token, err := auth.ApiKey(ctx)
 	if err != nil {
-		return &emptypb.Empty{}, err
+		return nil, err
 	}
 
 	s.G.GameLock.RLock()
 	tick := s.G.Game.Tick
 	// TODO add player write lock
-	err = handlers.AssignAttributes(s.G, attributes.Attributes, token)
+	result, err := handlers.AssignAttributes(ctx, s.G, attributes.Attributes, token)
 	s.G.GameLock.RUnlock()
 	if isBlocking(attributes.Blocking) {
-		s.G.WaitForNextTick(tick)
+		s.G.WaitForNextTick(ctx, tick)
 	}
 
-	return &emptypb.Empty{}, err
+	return result, err
 }
 
 func main() {
@@ -415,19 +747,73 @@ func main() {
 	// 	log.Fatal().Err(err).Msg("")
 	// }
 
-	g, err := dungeonsandtrolls.CreateGame()
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	delivery, err := keydelivery.FromConfig(cfg.KeyDelivery)
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
 	if err != nil {
 		log.Fatal().Err(err).Msg("")
 	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Warn().Err(err).Msg("flushing traces failed")
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", 8081))
+	gameCtx, stopGame := context.WithCancel(context.Background())
+	defer stopGame()
+	g, err := dungeonsandtrolls.CreateGame(gameCtx, cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	health := newHealth(g)
+	go health.watch()
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.GrpcPort))
 	if err != nil {
 		log.Fatal().Msgf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
-	api.RegisterDungeonsAndTrollsServer(s, &server{G: g})
+	// validated with the configuration
+	trustedProxies, _ := cfg.RateLimit.TrustedProxyNetworks()
+	limiter := ratelimit.New(ratelimit.Limits{
+		RequestsPerSecond:  cfg.RateLimit.RequestsPerSecond,
+		Burst:              cfg.RateLimit.Burst,
+		ConcurrentBlocking: cfg.RateLimit.ConcurrentBlocking,
+		TrustedProxies:     trustedProxies,
+	}, g)
+	requests := &drain{}
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), requests.UnaryServerInterceptor(), limiter.UnaryServerInterceptor(), auth.UnaryServerInterceptor(g)),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), metrics.StreamServerInterceptor(), requests.StreamServerInterceptor(), limiter.StreamServerInterceptor(), auth.StreamServerInterceptor(g)),
+	)
+	api.RegisterDungeonsAndTrollsServer(s, &server{G: g, KeyDelivery: delivery})
+	api.RegisterDungeonsAndTrollsAdminServer(s, &adminServer{G: g, Limiter: limiter})
+	healthpb.RegisterHealthServer(s, health.server)
 	log.Printf("server listening at %v", lis.Addr())
 
+	metricsAddr := cfg.Server.MetricsAddr
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:    metricsAddr,
+		Handler: metricsMux,
+	}
+	go func() {
+		log.Info().Msgf("Serving metrics on http://%s/metrics", metricsAddr)
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal().Msgf("failed to serve metrics: %v", err)
+		}
+	}()
+
 	go func() {
 		if err := s.Serve(lis); err != nil {
 			log.Fatal().Msgf("failed to serve: %v", err)
@@ -438,7 +824,7 @@ func main() {
 	// This is where the gRPC-Gateway proxies the requests
 	conn, err := grpc.DialContext(
 		context.Background(),
-		"0.0.0.0:8081",
+		fmt.Sprintf("0.0.0.0:%d", cfg.Server.GrpcPort),
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
@@ -448,20 +834,94 @@ func main() {
 
 	gwmux := runtime.NewServeMux(
 		runtime.WithMetadata(func(ctx context.Context, request *http.Request) metadata.MD {
-			header := request.Header.Get(apiKeyFieldName)
-			md := metadata.Pairs(apiKeyFieldName, header)
+			header := request.Header.Get(auth.ApiKeyFieldName)
+			md := metadata.Pairs(auth.ApiKeyFieldName, header)
+			// continue the traces of the HTTP clients
+			for _, h := range []string{"traceparent", "tracestate"} {
+				if v := request.Header.Get(h); v != "" {
+					md.Set(h, v)
+				}
+			}
 			return md
-		}))
+		}),
+		runtime.WithErrorHandler(ratelimit.RetryAfterErrorHandler))
 	err = api.RegisterDungeonsAndTrollsHandler(context.Background(), gwmux, conn)
 	if err != nil {
 		log.Fatal().Msgf("Failed to register gateway: %s", err)
 	}
+	err = api.RegisterDungeonsAndTrollsAdminHandler(context.Background(), gwmux, conn)
+	if err != nil {
+		log.Fatal().Msgf("Failed to register admin gateway: %s", err)
+	}
 
+	mux := http.NewServeMux()
+	mux.Handle("/", gwmux)
+	mux.HandleFunc("/healthz", health.healthz)
+	mux.HandleFunc("/readyz", health.readyz)
 	gwServer := &http.Server{
-		Addr:    ":8080",
-		Handler: gwmux,
+		Addr:    fmt.Sprintf(":%d", cfg.Server.HttpPort),
+		Handler: mux,
 	}
 
-	log.Info().Msg("Serving gRPC-Gateway on http://0.0.0.0:8080")
-	log.Fatal().Err(gwServer.ListenAndServe())
+	go func() {
+		log.Info().Msgf("Serving gRPC-Gateway on http://0.0.0.0:%d", cfg.Server.HttpPort)
+		if err := gwServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal().Msgf("failed to serve gateway: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	// a second signal kills the server immediately
+	stop()
+	log.Info().Msg("Shutting down")
+
+	// reject the new requests and let the running ones finish first so nothing changes the stored game
+	health.shutdown()
+	// every phase gets the whole timeout so a slow phase does not cut the following ones short
+	shutdownPhase := func(phase func(ctx context.Context)) {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		phase(ctx)
+	}
+	shutdownPhase(func(ctx context.Context) {
+		if err := requests.close(ctx); err != nil {
+			log.Warn().Err(err).Msg("requests were not drained in time")
+		}
+	})
+
+	// let the game loop finish the current tick and store the game, the streams end once the game stops
+	stopGame()
+	shutdownPhase(func(ctx context.Context) {
+		select {
+		case <-g.Done():
+		case <-ctx.Done():
+			log.Warn().Msg("game was not stopped in time")
+		}
+	})
+
+	shutdownPhase(func(ctx context.Context) {
+		if err := gwServer.Shutdown(ctx); err != nil {
+			log.Warn().Err(err).Msg("gateway shutdown failed")
+		}
+	})
+	shutdownPhase(func(ctx context.Context) {
+		grpcStopped := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(grpcStopped)
+		}()
+		select {
+		case <-grpcStopped:
+		case <-ctx.Done():
+			log.Warn().Msg("gRPC requests were not drained in time")
+			s.Stop()
+		}
+	})
+	conn.Close()
+	shutdownPhase(func(ctx context.Context) {
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			log.Warn().Err(err).Msg("metrics server shutdown failed")
+		}
+	})
+	log.Info().Msg("Server stopped")
 }